@@ -0,0 +1,217 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// fieldConverter 将字段值转换为目标类型，ok为false表示字段为null
+type fieldConverter func(v reflect.Value, ok bool) (reflect.Value, error)
+
+// newFieldConverter 根据字段的静态类型生成到目标类型的转换函数
+// 支持直接赋值、指针解引用、数值之间以及字符串类型之间的转换，接口类型的字段在运行时检查
+func newFieldConverter(path *fieldPath, typeName string, target reflect.Type) (fieldConverter, error) {
+	zero := reflect.Zero(target)
+	fieldType := indirectType(path.typ)
+	typeError := func(from reflect.Type) error {
+		return &FieldError{Type: typeName, Field: path.path, Err: ErrFieldType,
+			Detail: fmt.Sprintf("cannot convert %s to %s", from, target)}
+	}
+
+	switch {
+	case path.typ.AssignableTo(target):
+		return func(v reflect.Value, ok bool) (reflect.Value, error) {
+			if !ok {
+				return zero, nil
+			}
+			return v, nil
+		}, nil
+	case fieldType.Kind() == reflect.Interface:
+		return func(v reflect.Value, ok bool) (reflect.Value, error) {
+			if ok {
+				v, ok = indirect(v)
+			}
+			if !ok {
+				return zero, nil
+			}
+			if !convertibleType(v.Type(), target) {
+				return reflect.Value{}, typeError(v.Type())
+			}
+			converted, err := convertValue(v, target)
+			if err != nil {
+				return reflect.Value{}, &FieldError{Type: typeName, Field: path.path, Err: ErrFieldType, Detail: err.Error()}
+			}
+			return converted, nil
+		}, nil
+	case convertibleType(fieldType, target):
+		return func(v reflect.Value, ok bool) (reflect.Value, error) {
+			if ok {
+				v, ok = indirect(v)
+			}
+			if !ok {
+				return zero, nil
+			}
+			converted, err := convertValue(v, target)
+			if err != nil {
+				return reflect.Value{}, &FieldError{Type: typeName, Field: path.path, Err: ErrFieldType, Detail: err.Error()}
+			}
+			return converted, nil
+		}, nil
+	default:
+		return nil, typeError(path.typ)
+	}
+}
+
+// convertibleType 判断from类型的值能否转换为target类型
+func convertibleType(from, target reflect.Type) bool {
+	if from.AssignableTo(target) {
+		return true
+	}
+	if isNumeric(kindOf(from)) && isNumeric(kindOf(target)) {
+		return true
+	}
+	return from.Kind() == reflect.String && target.Kind() == reflect.String
+}
+
+// convertValue 将已解引用的值转换为target类型
+// 类型不兼容、数值溢出或浮点数转换为整数时丢失小数部分都会返回错误
+func convertValue(v reflect.Value, target reflect.Type) (reflect.Value, error) {
+	if !convertibleType(v.Type(), target) {
+		return reflect.Value{}, fmt.Errorf("cannot convert %s to %s", v.Type(), target)
+	}
+	if v.Type().AssignableTo(target) {
+		return v, nil
+	}
+	if isNumeric(kindOf(v.Type())) && !numberFits(v, target) {
+		return reflect.Value{}, fmt.Errorf("%s value %v does not fit in %s", v.Type(), v, target)
+	}
+	return v.Convert(target), nil
+}
+
+// numberFits 判断数值转换为target类型时是否不会溢出或丢失小数部分
+func numberFits(v reflect.Value, target reflect.Type) bool {
+	zero := reflect.Zero(target)
+	switch kindOf(target) {
+	case kindInt:
+		switch kindOf(v.Type()) {
+		case kindInt:
+			return !zero.OverflowInt(v.Int())
+		case kindUint:
+			return v.Uint() <= math.MaxInt64 && !zero.OverflowInt(int64(v.Uint()))
+		default:
+			f := v.Float()
+			return f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 && !zero.OverflowInt(int64(f))
+		}
+	case kindUint:
+		switch kindOf(v.Type()) {
+		case kindInt:
+			return v.Int() >= 0 && !zero.OverflowUint(uint64(v.Int()))
+		case kindUint:
+			return !zero.OverflowUint(v.Uint())
+		default:
+			f := v.Float()
+			return f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 && !zero.OverflowUint(uint64(f))
+		}
+	default:
+		return kindOf(v.Type()) != kindFloat || !zero.OverflowFloat(v.Float())
+	}
+}
+
+// PluckField 按字段名提取集合元素的字段值，字段可以使用Go字段名、json标签名或以"."分隔的嵌套路径
+// 字段为指针时会自动解引用，数值类型之间会自动转换，溢出或丢失小数部分时返回 ErrFieldType；路径上存在nil指针时提取U的零值
+func PluckField[U any, T any](c *Collection[T], field string) (*Collection[U], error) {
+	path, err := resolveFieldPathFor[T](field)
+	if err != nil {
+		return nil, err
+	}
+	typeName := reflect.TypeFor[T]().String()
+	convert, err := newFieldConverter(path, typeName, reflect.TypeFor[U]())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]U, len(c.items))
+	for i, item := range c.items {
+		v, err := convert(path.get(reflect.ValueOf(&item).Elem()))
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		reflect.ValueOf(&result[i]).Elem().Set(v)
+	}
+	return &Collection[U]{items: result}, nil
+}
+
+// SortByField 按字段名稳定排序，字段名前加"-"表示降序，加"+"或不加表示升序，依次作为主、次排序键
+// 字段必须为数值、字符串、布尔值或 time.Time（可以是指针），null值无论升降序都排在最后
+func SortByField[T any](c *Collection[T], fields ...string) (*Collection[T], error) {
+	typeName := reflect.TypeFor[T]().String()
+	sorter := &Sorter[T]{c: c, cached: true}
+	for _, field := range fields {
+		desc := strings.HasPrefix(field, "-")
+		name := strings.TrimLeft(field, "+-")
+		path, err := resolveFieldPathFor[T](name)
+		if err != nil {
+			return nil, err
+		}
+		fieldType := indirectType(path.typ)
+		if k := kindOf(fieldType); k == kindOther && fieldType.Kind() != reflect.Interface {
+			return nil, &FieldError{Type: typeName, Field: name, Err: ErrFieldType,
+				Detail: fmt.Sprintf("%s does not support ordering", fieldType)}
+		}
+		sorter.levels = append(sorter.levels, newFieldSortLevel[T](path, desc))
+	}
+	return sorter.Collect(), nil
+}
+
+// newFieldSortLevel 创建按字段值排序的层级，每个元素的字段值只读取一次
+func newFieldSortLevel[T any](path *fieldPath, desc bool) sortLevel[T] {
+	sign := 1
+	if desc {
+		sign = -1
+	}
+	return func(items []T, _ bool) func(i, j int) int {
+		keys := make([]reflect.Value, len(items))
+		for i := range items {
+			if v, ok := path.get(reflect.ValueOf(&items[i]).Elem()); ok {
+				keys[i], _ = indirect(v)
+			}
+		}
+		return func(i, j int) int {
+			a, b := keys[i], keys[j]
+			switch {
+			case !a.IsValid() && !b.IsValid():
+				return 0
+			case !a.IsValid():
+				return 1
+			case !b.IsValid():
+				return -1
+			}
+			return sign * orderValues(a, b)
+		}
+	}
+}
+
+// orderValues 比较两个字段值的大小，false小于true，无法比较的值视为相等
+func orderValues(a, b reflect.Value) int {
+	if a.Kind() == reflect.Bool && b.Kind() == reflect.Bool {
+		return compareOrdered(boolToInt(a.Bool()), boolToInt(b.Bool()))
+	}
+	if k := kindOf(a.Type()); k == kindBool || k == kindOther {
+		return 0
+	}
+	r, ok := compareValues(a, b)
+	if !ok {
+		return 0
+	}
+	return r
+}
+
+// boolToInt 将布尔值转换为整数
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,161 @@
+package collection
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPluckField(t *testing.T) {
+	users := whereFixtures()
+
+	names, err := PluckField[string](users, "name")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []string{"Alice", "Bob", "Carol", "alice_2"}; !slices.Equal(names.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, names.All())
+	}
+
+	ids, err := PluckField[int](users, "id")
+	if err != nil || !slices.Equal(ids.All(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4], got %v (%v)", ids.All(), err)
+	}
+
+	ages, err := PluckField[float64](users, "Age")
+	if err != nil || !slices.Equal(ages.All(), []float64{30, 25, 35, 18}) {
+		t.Errorf("Expected converted ages, got %v (%v)", ages.All(), err)
+	}
+
+	cities, err := PluckField[string](users, "Address.City")
+	if err != nil || !slices.Equal(cities.All(), []string{"Beijing", "Shanghai", "", "Beijing"}) {
+		t.Errorf("Expected cities with zero value for nil address, got %v (%v)", cities.All(), err)
+	}
+
+	zips, err := PluckField[string](users, "Address.Zip")
+	if err != nil || !slices.Equal(zips.All(), []string{"10001", "", "", ""}) {
+		t.Errorf("Expected dereferenced zips, got %v (%v)", zips.All(), err)
+	}
+
+	addresses, err := PluckField[*whereAddress](users, "address")
+	if err != nil || addresses.Count() != 4 || addresses.All()[2] != nil {
+		t.Errorf("Expected pointer values, got %v (%v)", addresses.All(), err)
+	}
+}
+
+func TestPluckFieldInterfaceNil(t *testing.T) {
+	users := whereFixtures()
+
+	metas, err := PluckField[any](users, "Meta")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []any{"vip", 3, nil, "vip"}; !slices.Equal(metas.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, metas.All())
+	}
+
+	cities, err := PluckField[any](users, "Address.City")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []any{"Beijing", "Shanghai", nil, "Beijing"}; !slices.Equal(cities.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, cities.All())
+	}
+}
+
+func TestPluckFieldErrors(t *testing.T) {
+	users := whereFixtures()
+
+	if _, err := PluckField[string](users, "email"); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+	if _, err := PluckField[string](users, "Age"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+
+	_, err := PluckField[string](users, "Meta")
+	var indexErr *IndexError
+	if !errors.Is(err, ErrFieldType) || !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected field type error at index 1, got %v", err)
+	}
+}
+
+func TestPluckFieldLossyConversion(t *testing.T) {
+	type Reading struct {
+		Value float64
+		Count uint64
+	}
+	readings := New(Reading{Value: 9, Count: 100}, Reading{Value: 9.5, Count: 300})
+
+	_, err := PluckField[int](readings, "Value")
+	var indexErr *IndexError
+	if !errors.Is(err, ErrFieldType) || !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected field type error at index 1 for fraction, got %v", err)
+	}
+	if _, err := PluckField[int8](readings, "Count"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error for overflow, got %v", err)
+	}
+	counts, err := PluckField[int16](readings, "Count")
+	if err != nil || !slices.Equal(counts.All(), []int16{100, 300}) {
+		t.Errorf("Expected [100 300], got %v (%v)", counts.All(), err)
+	}
+
+	type Tagged struct {
+		Meta any
+	}
+	if _, err := PluckField[uint](New(Tagged{Meta: -1}), "Meta"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error for negative interface value, got %v", err)
+	}
+}
+
+func TestSortByField(t *testing.T) {
+	users := whereFixtures()
+	tests := []struct {
+		fields   []string
+		expected []string
+	}{
+		{[]string{"age"}, []string{"alice_2", "Bob", "Alice", "Carol"}},
+		{[]string{"-created_at"}, []string{"alice_2", "Carol", "Bob", "Alice"}},
+		{[]string{"-Active", "+name"}, []string{"Alice", "Carol", "Bob", "alice_2"}},
+		{[]string{"Address.City", "-id"}, []string{"alice_2", "Alice", "Bob", "Carol"}},
+		{[]string{"-Address.City"}, []string{"Bob", "Alice", "alice_2", "Carol"}},
+		{nil, []string{"Alice", "Bob", "Carol", "alice_2"}},
+	}
+	for _, tt := range tests {
+		sorted, err := SortByField(users, tt.fields...)
+		if names := whereNames(t, sorted, err); !slices.Equal(names, tt.expected) {
+			t.Errorf("SortByField(%v): expected %v, got %v", tt.fields, tt.expected, names)
+		}
+	}
+}
+
+func TestSortByFieldErrors(t *testing.T) {
+	users := whereFixtures()
+
+	if _, err := SortByField(users, "-missing"); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+	if _, err := SortByField(users, "Address"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+}
+
+func TestSortByFieldTime(t *testing.T) {
+	type event struct {
+		Name string
+		At   *time.Time
+	}
+	early := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(time.Hour)
+	events := New(event{"late", &late}, event{"none", nil}, event{"early", &early})
+
+	sorted, err := SortByField(events, "At")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	names := Map(sorted, func(e event) string { return e.Name }).All()
+	if expected := []string{"early", "late", "none"}; !slices.Equal(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
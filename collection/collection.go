@@ -339,55 +339,22 @@ func Pluck[T any, U any](c *Collection[T], fn func(T) U) *Collection[U] {
 
 // Sum 计算集合元素的总和
 func Sum[T any](c *Collection[T], fn func(T) float64) float64 {
-	sum := 0.0
-	for _, item := range c.items {
-		sum += fn(item)
-	}
-	return sum
+	return SumOf(c, fn)
 }
 
 // Avg 计算集合元素的平均值
 func Avg[T any](c *Collection[T], fn func(T) float64) float64 {
-	if len(c.items) == 0 {
-		return 0
-	}
-	return Sum(c, fn) / float64(len(c.items))
+	return AvgOf(c, fn)
 }
 
 // Min 获取集合中的最小值
 func Min[T any](c *Collection[T], fn func(T) float64) (T, bool) {
-	if len(c.items) == 0 {
-		var zero T
-		return zero, false
-	}
-	minItem := c.items[0]
-	minValue := fn(minItem)
-	for _, item := range c.items[1:] {
-		value := fn(item)
-		if value < minValue {
-			minValue = value
-			minItem = item
-		}
-	}
-	return minItem, true
+	return MinBy(c, fn)
 }
 
 // Max 获取集合中的最大值
 func Max[T any](c *Collection[T], fn func(T) float64) (T, bool) {
-	if len(c.items) == 0 {
-		var zero T
-		return zero, false
-	}
-	maxItem := c.items[0]
-	maxValue := fn(maxItem)
-	for _, item := range c.items[1:] {
-		value := fn(item)
-		if value > maxValue {
-			maxValue = value
-			maxItem = item
-		}
-	}
-	return maxItem, true
+	return MaxBy(c, fn)
 }
 
 // Flatten 将多维集合扁平化为一维集合
@@ -408,19 +375,6 @@ func FlatMap[T, U any](c *Collection[T], fn func(T) []U) *Collection[U] {
 	return &Collection[U]{items: flattened}
 }
 
-// Zip 将多个集合合并为一个集合
-func Zip[T, U any](c1 *Collection[T], c2 *Collection[U]) *Collection[[2]any] {
-	length := len(c1.items)
-	if len(c2.items) < length {
-		length = len(c2.items)
-	}
-	zipped := make([][2]any, length)
-	for i := 0; i < length; i++ {
-		zipped[i] = [2]any{c1.items[i], c2.items[i]}
-	}
-	return &Collection[[2]any]{items: zipped}
-}
-
 // Join 将集合元素连接成字符串
 func Join[T any](c *Collection[T], separator string, fn func(T) string) string {
 	if len(c.items) == 0 {
@@ -433,9 +387,9 @@ func Join[T any](c *Collection[T], separator string, fn func(T) string) string {
 	return result
 }
 
-// ToJSON 将集合转换为JSON字符串
+// ToJSON 将集合转换为JSON字符串，与 MarshalJSON 的结果一致，空集合编码为[]
 func (c *Collection[T]) ToJSON() (string, error) {
-	data, err := json.Marshal(c.items)
+	data, err := c.MarshalJSON()
 	if err != nil {
 		return "", err
 	}
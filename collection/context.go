@@ -0,0 +1,146 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+)
+
+// EachCtx 遍历集合中的每个元素，每处理一个元素前检查ctx是否已取消
+func (c *Collection[T]) EachCtx(ctx context.Context, fn func(T)) error {
+	for _, item := range c.items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		fn(item)
+	}
+	return nil
+}
+
+// MapCtx 对集合中的每个元素应用回调函数，ctx取消时返回nil集合和ctx.Err()
+func MapCtx[T, U any](ctx context.Context, c *Collection[T], fn func(T) U) (*Collection[U], error) {
+	mapped := make([]U, len(c.items))
+	for i, item := range c.items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		mapped[i] = fn(item)
+	}
+	return &Collection[U]{items: mapped}, nil
+}
+
+// FilterCtx 根据给定的回调函数过滤集合，ctx取消时返回nil集合和ctx.Err()
+func (c *Collection[T]) FilterCtx(ctx context.Context, fn func(T) bool) (*Collection[T], error) {
+	filtered := make([]T, 0)
+	for _, item := range c.items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if fn(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return &Collection[T]{items: filtered}, nil
+}
+
+// ReduceCtx 将集合缩减为单个值，ctx取消时返回已累积的值和ctx.Err()
+func ReduceCtx[T, U any](ctx context.Context, c *Collection[T], fn func(U, T) U, initial U) (U, error) {
+	result := initial
+	for _, item := range c.items {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		result = fn(result, item)
+	}
+	return result, nil
+}
+
+// EachChunkCtx 按指定大小分块处理集合，每处理一个块前检查ctx是否已取消
+// 回调返回错误时立即停止并返回该错误，size小于等于0时返回 ErrInvalidSize
+func (c *Collection[T]) EachChunkCtx(ctx context.Context, size int, fn func([]T) error) error {
+	if size <= 0 {
+		return fmt.Errorf("%w: chunk size must be positive, got %d", ErrInvalidSize, size)
+	}
+	for _, chunk := range Chunk(c, size) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithContext 返回在ctx取消后结束的惰性集合，每从上游读取一个元素都会检查ctx
+// 放在 Filter 等中间操作之前，即使元素都被过滤掉也能及时停止读取数据源
+// 该阶段只结束数据流，取消的错误由使用同一ctx的 EachCtx、CollectCtx、EachChunkCtx 和 LazyReduceCtx 返回
+func (l *LazyCollection[T]) WithContext(ctx context.Context) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for item := range l.seq {
+			if ctx.Err() != nil || !yield(item) {
+				return
+			}
+		}
+	}}
+}
+
+// EachCtx 执行惰性集合并遍历每个元素，ctx取消时停止读取并返回ctx.Err()
+// 每个到达终止操作的元素之前都会检查ctx；上游有过滤操作时请在数据源后调用 WithContext
+func (l *LazyCollection[T]) EachCtx(ctx context.Context, fn func(T)) error {
+	for item := range l.seq {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		fn(item)
+	}
+	return ctx.Err()
+}
+
+// CollectCtx 执行惰性集合并返回普通集合，ctx取消时返回nil集合和ctx.Err()
+func (l *LazyCollection[T]) CollectCtx(ctx context.Context) (*Collection[T], error) {
+	items := make([]T, 0)
+	err := l.EachCtx(ctx, func(item T) {
+		items = append(items, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Collection[T]{items: items}, nil
+}
+
+// EachChunkCtx 按指定大小从惰性集合中读取块并处理，只在内存中保留当前块
+// 回调返回错误时立即停止并返回该错误，size小于等于0时返回 ErrInvalidSize
+func (l *LazyCollection[T]) EachChunkCtx(ctx context.Context, size int, fn func([]T) error) error {
+	if size <= 0 {
+		return fmt.Errorf("%w: chunk size must be positive, got %d", ErrInvalidSize, size)
+	}
+	var chunk []T
+	for item := range l.seq {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		chunk = append(chunk, item)
+		if len(chunk) == size {
+			if err := fn(chunk); err != nil {
+				return err
+			}
+			chunk = nil
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(chunk) > 0 {
+		return fn(chunk)
+	}
+	return nil
+}
+
+// LazyReduceCtx 执行惰性集合并将其缩减为单个值，ctx取消时返回已累积的值和ctx.Err()
+func LazyReduceCtx[T, U any](ctx context.Context, l *LazyCollection[T], fn func(U, T) U, initial U) (U, error) {
+	result := initial
+	err := l.EachCtx(ctx, func(item T) {
+		result = fn(result, item)
+	})
+	return result, err
+}
@@ -0,0 +1,144 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestEachCtxCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	visited := 0
+	err := New(1, 2, 3, 4, 5).EachCtx(ctx, func(n int) {
+		visited++
+		if n == 2 {
+			cancel()
+		}
+	})
+	if !errors.Is(err, context.Canceled) || visited != 2 {
+		t.Errorf("Expected cancel after 2 elements, got %v visited %d", err, visited)
+	}
+}
+
+func TestMapCtx(t *testing.T) {
+	mapped, err := MapCtx(context.Background(), New(1, 2, 3), func(n int) int { return n * 2 })
+	if err != nil || !slices.Equal(mapped.All(), []int{2, 4, 6}) {
+		t.Errorf("MapCtx failed: %v %v", mapped, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mapped, err = MapCtx(ctx, New(1, 2, 3), func(n int) int { return n * 2 })
+	if mapped != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected cancelled MapCtx, got %v %v", mapped, err)
+	}
+}
+
+func TestFilterCtx(t *testing.T) {
+	filtered, err := New(1, 2, 3, 4).FilterCtx(context.Background(), func(n int) bool { return n%2 == 0 })
+	if err != nil || !slices.Equal(filtered.All(), []int{2, 4}) {
+		t.Errorf("FilterCtx failed: %v %v", filtered, err)
+	}
+}
+
+func TestReduceCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sum, err := ReduceCtx(ctx, New(1, 2, 3, 4), func(acc, n int) int {
+		if n == 2 {
+			cancel()
+		}
+		return acc + n
+	}, 0)
+	if sum != 3 || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected partial sum 3 with cancel, got %d %v", sum, err)
+	}
+}
+
+func TestEachChunkCtx(t *testing.T) {
+	chunks := make([][]int, 0)
+	err := New(1, 2, 3, 4, 5).EachChunkCtx(context.Background(), 2, func(chunk []int) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	if err != nil || len(chunks) != 3 || !slices.Equal(chunks[2], []int{5}) {
+		t.Errorf("EachChunkCtx failed: %v %v", chunks, err)
+	}
+
+	stop := errors.New("stop")
+	err = New(1, 2, 3, 4, 5).EachChunkCtx(context.Background(), 2, func(chunk []int) error {
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("Expected callback error, got %v", err)
+	}
+
+	if err := New(1, 2).EachChunkCtx(context.Background(), 0, func([]int) error { return nil }); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize, got %v", err)
+	}
+	if err := New(1, 2).Lazy().EachChunkCtx(context.Background(), -1, func([]int) error { return nil }); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize for lazy collection, got %v", err)
+	}
+}
+
+func TestLazyCtxStopsPulling(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pulled := 0
+	source := NewLazy(func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if i == 10 {
+				cancel()
+			}
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	collected, err := source.WithContext(ctx).Filter(func(n int) bool { return n < 0 }).CollectCtx(ctx)
+	if collected != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected cancelled collect, got %v %v", collected, err)
+	}
+	if pulled != 11 {
+		t.Errorf("Expected 11 items pulled, got %d", pulled)
+	}
+}
+
+func TestEachChunkCtxHugeSize(t *testing.T) {
+	var sizes []int
+	collect := func(chunk []int) error {
+		sizes = append(sizes, len(chunk))
+		return nil
+	}
+	if err := New(1, 2, 3).EachChunkCtx(context.Background(), math.MaxInt, collect); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := New(1, 2, 3).Lazy().EachChunkCtx(context.Background(), math.MaxInt, collect); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(sizes, []int{3, 3}) {
+		t.Errorf("Expected chunk sizes [3 3], got %v", sizes)
+	}
+}
+
+func TestLazyMapReduceCtx(t *testing.T) {
+	ctx := context.Background()
+	doubled := LazyMap(New(1, 2, 3).Lazy(), func(n int) int { return n * 2 })
+	sum, err := LazyReduceCtx(ctx, doubled, func(acc, n int) int { return acc + n }, 0)
+	if err != nil || sum != 12 {
+		t.Errorf("Expected sum 12, got %d %v", sum, err)
+	}
+}
+
+func TestLazyEachChunkCtx(t *testing.T) {
+	sizes := make([]int, 0)
+	err := New(1, 2, 3, 4, 5, 6, 7).Lazy().EachChunkCtx(context.Background(), 3, func(chunk []int) error {
+		sizes = append(sizes, len(chunk))
+		return nil
+	})
+	if err != nil || !slices.Equal(sizes, []int{3, 3, 1}) {
+		t.Errorf("Expected chunk sizes [3 3 1], got %v %v", sizes, err)
+	}
+}
@@ -0,0 +1,449 @@
+package collection
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCSVType 集合元素不是结构体或结构体指针，无法与CSV互相转换
+var ErrCSVType = errors.New("collection: csv requires a struct element type")
+
+// LineError 记录读取时出错的行号（从1开始）及列名
+type LineError struct {
+	Line   int
+	Column string
+	Err    error
+}
+
+// Error 实现error接口
+func (e *LineError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("collection: line %d, column %q: %v", e.Line, e.Column, e.Err)
+	}
+	return fmt.Sprintf("collection: line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap 返回原始错误
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// CSVOptions CSV读写选项，零值表示逗号分隔、带表头、时间格式为 time.RFC3339
+type CSVOptions struct {
+	// Comma 字段分隔符，为0时使用','
+	Comma rune
+	// Comment 读取时以该字符开头的行被视为注释，为0时不启用
+	Comment rune
+	// NoHeader 读取时第一行不是表头，按 Columns 或字段声明顺序对应列；写入时不输出表头
+	NoHeader bool
+	// Columns 列名及其顺序，为空时使用所有字段
+	Columns []string
+	// TimeFormat time.Time 字段的格式，为空时使用 time.RFC3339
+	TimeFormat string
+	// FloatFormat 写入浮点数时使用的 fmt 格式，例如 "%.2f"，为空时使用最短的十进制表示
+	FloatFormat string
+	// DecimalSeparator 数值的小数点，例如 ','，为0时使用'.'；读取和写入浮点数时都会使用
+	DecimalSeparator rune
+	// ThousandsSeparator 读取数值时忽略的千位分隔符，例如 ','，为0时不启用；写入时不输出千位分隔符
+	ThousandsSeparator rune
+	// ErrorMode 读取时遇到错误行的处理方式，CollectErrors 模式下跳过错误行并返回合并后的错误
+	ErrorMode ErrorMode
+}
+
+// timeFormat 返回时间格式
+func (o *CSVOptions) timeFormat() string {
+	if o.TimeFormat == "" {
+		return time.RFC3339
+	}
+	return o.TimeFormat
+}
+
+// parseNumber 去掉单元格中的空白和千位分隔符，并将小数点转换为'.'
+func (o *CSVOptions) parseNumber(s string) string {
+	s = strings.TrimSpace(s)
+	if o.ThousandsSeparator != 0 {
+		s = strings.ReplaceAll(s, string(o.ThousandsSeparator), "")
+	}
+	if o.DecimalSeparator != 0 && o.DecimalSeparator != '.' {
+		s = strings.ReplaceAll(s, string(o.DecimalSeparator), ".")
+	}
+	return s
+}
+
+// formatNumber 将浮点数文本中的'.'替换为小数点
+func (o *CSVOptions) formatNumber(s string) string {
+	if o.DecimalSeparator != 0 && o.DecimalSeparator != '.' {
+		return strings.ReplaceAll(s, ".", string(o.DecimalSeparator))
+	}
+	return s
+}
+
+// csvColumn 结构体字段对应的CSV列
+type csvColumn struct {
+	name  string
+	index []int
+	typ   reflect.Type
+}
+
+// csvColumnCache 缓存每个结构体类型的CSV列
+var csvColumnCache sync.Map // map[reflect.Type][]*csvColumn
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// csvColumnsOf 返回结构体类型的CSV列，列名依次取 csv 标签、json 标签和Go字段名，标签为"-"的字段被忽略
+// 只包含可以与字符串互相转换的字段：基本类型、time.Time、time.Duration、实现了 encoding.TextMarshaler 的类型及它们的指针
+// 与 encoding/json 一样，通过未导出的嵌入指针提升的字段被忽略，因为读取时无法为其分配内存
+func csvColumnsOf(t reflect.Type) []*csvColumn {
+	if cached, ok := csvColumnCache.Load(t); ok {
+		return cached.([]*csvColumn)
+	}
+
+	columns := make([]*csvColumn, 0)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous || !csvSupported(f.Type) || viaUnexportedPointer(t, f.Index) {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tagName, _, _ := strings.Cut(tag, ","); tagName != "" {
+				name = tagName
+			}
+		} else if tag, ok := f.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tagName := jsonFieldName(f); tagName != "" {
+				name = tagName
+			}
+		}
+		columns = append(columns, &csvColumn{name: name, index: f.Index, typ: f.Type})
+	}
+	actual, _ := csvColumnCache.LoadOrStore(t, columns)
+	return actual.([]*csvColumn)
+}
+
+// viaUnexportedPointer 判断索引路径是否经过未导出的嵌入指针
+func viaUnexportedPointer(t reflect.Type, index []int) bool {
+	for _, x := range index[:len(index)-1] {
+		f := t.Field(x)
+		if !f.IsExported() && f.Type.Kind() == reflect.Pointer {
+			return true
+		}
+		t = indirectType(f.Type)
+	}
+	return false
+}
+
+// csvSupported 判断字段类型能否与CSV单元格互相转换
+func csvSupported(t reflect.Type) bool {
+	t = indirectType(t)
+	if t == timeType || t == durationType {
+		return true
+	}
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) && t.Implements(textMarshalerType) {
+		return true
+	}
+	return isNumeric(kindOf(t)) || t.Kind() == reflect.String || t.Kind() == reflect.Bool
+}
+
+// csvStructType 返回集合元素对应的结构体类型
+func csvStructType[T any]() (reflect.Type, error) {
+	t := indirectType(reflect.TypeFor[T]())
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w, got %s", ErrCSVType, reflect.TypeFor[T]())
+	}
+	return t, nil
+}
+
+// selectColumns 按names选择列，names为空时返回所有列
+func selectColumns(t reflect.Type, names []string) ([]*csvColumn, error) {
+	columns := csvColumnsOf(t)
+	if len(names) == 0 {
+		return columns, nil
+	}
+	selected := make([]*csvColumn, len(names))
+	for i, name := range names {
+		for _, column := range columns {
+			if column.name == name {
+				selected[i] = column
+				break
+			}
+		}
+		if selected[i] == nil {
+			return nil, &FieldError{Type: t.String(), Field: name, Err: ErrUnknownField, Detail: "no csv column with this name"}
+		}
+	}
+	return selected, nil
+}
+
+// FromCSV 从CSV流中逐行读取并创建集合，T必须是结构体或结构体指针
+// 默认第一行为表头，按列名对应字段，未知的列被忽略，缺失的列保持零值
+// 空单元格对应零值，指针字段对应nil；解析失败的单元格返回包含行号和列名的 *LineError
+func FromCSV[T any](r io.Reader, opts CSVOptions) (*Collection[T], error) {
+	structType, err := csvStructType[T]()
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.Comment = opts.Comment
+	reader.ReuseRecord = true
+
+	var columns []*csvColumn
+	if opts.NoHeader {
+		if columns, err = selectColumns(structType, opts.Columns); err != nil {
+			return nil, err
+		}
+	}
+
+	isPointer := reflect.TypeFor[T]().Kind() == reflect.Pointer
+	items := make([]T, 0)
+	var errs []error
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) && errors.Is(err, csv.ErrFieldCount) && opts.ErrorMode == CollectErrors {
+				errs = append(errs, &LineError{Line: parseErr.StartLine, Err: parseErr.Err})
+				continue
+			}
+			return nil, err
+		}
+		if columns == nil {
+			columns = csvHeaderColumns(structType, record)
+			continue
+		}
+
+		item := reflect.New(structType)
+		if rowErr := decodeCSVRecord(reader, item.Elem(), record, columns, &opts); rowErr != nil {
+			if opts.ErrorMode != CollectErrors {
+				return nil, rowErr
+			}
+			errs = append(errs, rowErr)
+			continue
+		}
+		if isPointer {
+			items = append(items, item.Interface().(T))
+		} else {
+			items = append(items, item.Elem().Interface().(T))
+		}
+	}
+	return &Collection[T]{items: items}, errors.Join(errs...)
+}
+
+// csvHeaderColumns 按表头对应列，未知的列对应nil
+func csvHeaderColumns(t reflect.Type, header []string) []*csvColumn {
+	byName := make(map[string]*csvColumn)
+	for _, column := range csvColumnsOf(t) {
+		byName[column.name] = column
+	}
+	columns := make([]*csvColumn, len(header))
+	for i, name := range header {
+		columns[i] = byName[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))]
+	}
+	return columns
+}
+
+// decodeCSVRecord 将一行记录解码到结构体
+func decodeCSVRecord(reader *csv.Reader, item reflect.Value, record []string, columns []*csvColumn, opts *CSVOptions) error {
+	for i, column := range columns {
+		if column == nil || i >= len(record) {
+			continue
+		}
+		field := fieldByIndexAlloc(item, column.index)
+		if err := parseCSVValue(field, record[i], opts); err != nil {
+			line, _ := reader.FieldPos(i)
+			return &LineError{Line: line, Column: column.name, Err: err}
+		}
+	}
+	return nil
+}
+
+// fieldByIndexAlloc 按索引路径获取字段，路径上的nil嵌入指针会被分配
+func fieldByIndexAlloc(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// parseCSVValue 将单元格解析到字段
+func parseCSVValue(v reflect.Value, s string, opts *CSVOptions) error {
+	if v.Kind() == reflect.Pointer {
+		if s == "" {
+			v.SetZero()
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem())
+		if err := parseCSVValue(elem.Elem(), s, opts); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+	if s == "" {
+		v.SetZero()
+		return nil
+	}
+
+	switch {
+	case v.Type() == timeType:
+		parsed, err := time.Parse(opts.timeFormat(), s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(parsed))
+		return nil
+	case v.Type() == durationType:
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(parsed))
+		return nil
+	case v.Addr().Type().Implements(textUnmarshalerType):
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch kindOf(v.Type()) {
+	case kindString:
+		v.SetString(s)
+	case kindBool:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+		v.SetBool(parsed)
+	case kindInt:
+		parsed, err := strconv.ParseInt(opts.parseNumber(s), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(parsed)
+	case kindUint:
+		parsed, err := strconv.ParseUint(opts.parseNumber(s), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(parsed)
+	case kindFloat:
+		parsed, err := strconv.ParseFloat(opts.parseNumber(s), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(parsed)
+	}
+	return nil
+}
+
+// WriteCSV 将集合逐行写入CSV流，T必须是结构体或结构体指针，nil元素的所有单元格为空
+// 默认输出包含所有字段的表头，可以通过 Columns 选择列及其顺序
+func (c *Collection[T]) WriteCSV(w io.Writer, opts CSVOptions) error {
+	structType, err := csvStructType[T]()
+	if err != nil {
+		return err
+	}
+	columns, err := selectColumns(structType, opts.Columns)
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+
+	record := make([]string, len(columns))
+	if !opts.NoHeader {
+		for i, column := range columns {
+			record[i] = column.name
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	for _, item := range c.items {
+		v, ok := indirect(reflect.ValueOf(&item).Elem())
+		for i, column := range columns {
+			record[i] = ""
+			if !ok {
+				continue
+			}
+			field, err := v.FieldByIndexErr(column.index)
+			if err != nil {
+				continue
+			}
+			if record[i], err = formatCSVValue(field, &opts); err != nil {
+				return &FieldError{Type: structType.String(), Field: column.name, Err: err}
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatCSVValue 将字段格式化为单元格
+func formatCSVValue(v reflect.Value, opts *CSVOptions) (string, error) {
+	v, ok := indirect(v)
+	if !ok {
+		return "", nil
+	}
+	switch {
+	case v.Type() == timeType:
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(opts.timeFormat()), nil
+	case v.Type() == durationType:
+		return time.Duration(v.Int()).String(), nil
+	case v.Type().Implements(textMarshalerType):
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch kindOf(v.Type()) {
+	case kindString:
+		return v.String(), nil
+	case kindBool:
+		return strconv.FormatBool(v.Bool()), nil
+	case kindInt:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case kindUint:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case kindFloat:
+		if opts.FloatFormat != "" {
+			return opts.formatNumber(fmt.Sprintf(opts.FloatFormat, v.Float())), nil
+		}
+		return opts.formatNumber(strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,192 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type csvMeta struct {
+	Source string `csv:"source"`
+}
+
+type csvRecord struct {
+	csvMeta
+	ID        int           `csv:"id"`
+	Name      string        `json:"name"`
+	Score     float64       `csv:"score"`
+	Active    bool          `csv:"active"`
+	CreatedAt time.Time     `csv:"created_at"`
+	Timeout   time.Duration `csv:"timeout"`
+	Nickname  *string       `csv:"nickname"`
+	Secret    string        `csv:"-"`
+	Tags      []string
+}
+
+func TestFromCSV(t *testing.T) {
+	input := "id,name,score,active,created_at,timeout,nickname,source,unknown\n" +
+		"1,Alice,9.5,true,2024-01-02T03:04:05Z,1m30s,Ali,web,x\n" +
+		"2,Bob,,false,,,,api,y\n"
+	records, err := FromCSV[csvRecord](strings.NewReader(input), CSVOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if records.Count() != 2 {
+		t.Fatalf("Expected 2 records, got %d", records.Count())
+	}
+	alice := records.All()[0]
+	if alice.ID != 1 || alice.Name != "Alice" || alice.Score != 9.5 || !alice.Active || alice.Source != "web" {
+		t.Errorf("Unexpected record %+v", alice)
+	}
+	if !alice.CreatedAt.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) || alice.Timeout != 90*time.Second {
+		t.Errorf("Unexpected time fields %v %v", alice.CreatedAt, alice.Timeout)
+	}
+	if alice.Nickname == nil || *alice.Nickname != "Ali" {
+		t.Errorf("Expected nickname Ali, got %v", alice.Nickname)
+	}
+	bob := records.All()[1]
+	if bob.Score != 0 || !bob.CreatedAt.IsZero() || bob.Nickname != nil {
+		t.Errorf("Expected zero values for empty cells, got %+v", bob)
+	}
+}
+
+func TestFromCSVOptions(t *testing.T) {
+	input := "# exported\n1;Alice;02/01/2024\n2;Bob;03/01/2024\n"
+	records, err := FromCSV[*csvRecord](strings.NewReader(input), CSVOptions{
+		Comma:      ';',
+		Comment:    '#',
+		NoHeader:   true,
+		Columns:    []string{"id", "name", "created_at"},
+		TimeFormat: "02/01/2006",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if records.Count() != 2 || records.All()[1].Name != "Bob" || records.All()[1].CreatedAt.Month() != time.January {
+		t.Errorf("Unexpected records %+v", records.All())
+	}
+
+	if _, err := FromCSV[csvRecord](strings.NewReader(input), CSVOptions{NoHeader: true, Columns: []string{"email"}}); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+	if _, err := FromCSV[int](strings.NewReader(input), CSVOptions{}); !errors.Is(err, ErrCSVType) {
+		t.Errorf("Expected csv type error, got %v", err)
+	}
+}
+
+func TestFromCSVErrors(t *testing.T) {
+	input := "id,name,score\n1,Alice,9.5\ntwo,Bob,1\n3,Carol\n4,Dave,oops\n5,Eve,2\n"
+
+	_, err := FromCSV[csvRecord](strings.NewReader(input), CSVOptions{})
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 3 || lineErr.Column != "id" {
+		t.Errorf("Expected error on line 3 column id, got %v", err)
+	}
+
+	records, err := FromCSV[csvRecord](strings.NewReader(input), CSVOptions{ErrorMode: CollectErrors})
+	if records.Count() != 2 || records.All()[1].Name != "Eve" {
+		t.Errorf("Expected valid rows to be kept, got %+v", records.All())
+	}
+	for _, expected := range []string{"line 3", "line 4", "line 5, column \"score\""} {
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error to mention %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestCSVNumberFormat(t *testing.T) {
+	input := "id;score\n\"1.234\";\"1.234,50\"\n2;-0,5\n"
+	opts := CSVOptions{Comma: ';', DecimalSeparator: ',', ThousandsSeparator: '.'}
+	records, err := FromCSV[csvRecord](strings.NewReader(input), opts)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first := records.All()[0]; first.ID != 1234 || first.Score != 1234.5 {
+		t.Errorf("Expected 1234 and 1234.5, got %d %v", first.ID, first.Score)
+	}
+	if second := records.All()[1]; second.Score != -0.5 {
+		t.Errorf("Expected -0.5, got %v", second.Score)
+	}
+
+	records, err = FromCSV[csvRecord](strings.NewReader("score\n\"1,234.50\"\n"), CSVOptions{ThousandsSeparator: ','})
+	if first, _ := records.First(); err != nil || first.Score != 1234.5 {
+		t.Errorf("Expected 1234.5, got %v %v", first.Score, err)
+	}
+
+	var buf bytes.Buffer
+	opts.Columns = []string{"score"}
+	if err := records.WriteCSV(&buf, opts); err != nil || buf.String() != "score\n1234,5\n" {
+		t.Errorf("Expected comma decimal separator, got %q %v", buf.String(), err)
+	}
+}
+
+func TestFromCSVUnexportedEmbeddedPointer(t *testing.T) {
+	type inner struct {
+		Source string
+	}
+	type outer struct {
+		*inner
+		Name string
+	}
+	records, err := FromCSV[outer](strings.NewReader("Source,Name\nweb,Alice\n"), CSVOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first, _ := records.First(); first.Name != "Alice" || first.inner != nil {
+		t.Errorf("Expected promoted field to be skipped, got %+v", first)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	nickname := "Ali"
+	records := New(
+		csvRecord{csvMeta: csvMeta{"web"}, ID: 1, Name: "Alice", Score: 9.5, Active: true,
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), Timeout: time.Minute, Nickname: &nickname, Secret: "x"},
+		csvRecord{ID: 2, Name: "Bob, Jr.", Score: 1.0 / 3},
+	)
+
+	var buf bytes.Buffer
+	if err := records.WriteCSV(&buf, CSVOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "source,id,name,score,active,created_at,timeout,nickname\n" +
+		"web,1,Alice,9.5,true,2024-01-02T03:04:05Z,1m0s,Ali\n" +
+		",2,\"Bob, Jr.\",0.3333333333333333,false,,0s,\n"
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	err := records.WriteCSV(&buf, CSVOptions{Comma: '\t', NoHeader: true, Columns: []string{"name", "score"}, FloatFormat: "%.2f"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := "Alice\t9.50\nBob, Jr.\t0.33\n"; buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	if err := records.WriteCSV(&buf, CSVOptions{Columns: []string{"Secret"}}); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	records := New(
+		&csvRecord{ID: 1, Name: "张三", Score: 2.5, CreatedAt: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)},
+		nil,
+		&csvRecord{ID: 3, Name: "line\nbreak", Active: true},
+	)
+	var buf bytes.Buffer
+	if err := records.WriteCSV(&buf, CSVOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	decoded, err := FromCSV[*csvRecord](&buf, CSVOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Count() != 3 || decoded.All()[0].Name != "张三" || decoded.All()[2].Name != "line\nbreak" || decoded.All()[1].ID != 0 {
+		t.Errorf("Unexpected round trip result %+v", decoded.All())
+	}
+}
@@ -0,0 +1,573 @@
+package collection
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrPathNotFound 点路径在数据中不存在
+var ErrPathNotFound = errors.New("collection: path not found")
+
+// dataWildcard 点路径中匹配 map 或切片所有元素的通配符
+const dataWildcard = "*"
+
+// dataPath 一次点路径访问的上下文
+type dataPath struct {
+	root     reflect.Type
+	path     string
+	segments []string
+}
+
+// newDataPath 解析点路径，空路径表示数据本身
+func newDataPath(root any, path string) *dataPath {
+	var segments []string
+	if path != "" {
+		segments = strings.Split(path, ".")
+	}
+	return &dataPath{root: reflect.TypeOf(root), path: path, segments: segments}
+}
+
+// errorAt 创建第i个路径段处的错误
+func (p *dataPath) errorAt(i int, err error, format string, args ...any) error {
+	return &FieldError{Type: typeNameOf(p.root), Field: p.path, Err: err,
+		Detail: fmt.Sprintf("at %q: ", strings.Join(p.segments[:i+1], ".")) + fmt.Sprintf(format, args...)}
+}
+
+// hasWildcard 判断第i个路径段及之后是否包含通配符
+func (p *dataPath) hasWildcard(i int) bool {
+	return slices.Contains(p.segments[i:], dataWildcard)
+}
+
+// lookup 从第i个路径段开始读取v中的值
+func (p *dataPath) lookup(v reflect.Value, i int) (any, error) {
+	if i == len(p.segments) {
+		if !v.IsValid() {
+			return nil, nil
+		}
+		return v.Interface(), nil
+	}
+	segment := p.segments[i]
+	v, ok := indirect(v)
+	if !ok {
+		return nil, p.errorAt(i, ErrPathNotFound, "nil value")
+	}
+	if segment == dataWildcard {
+		return p.lookupAll(v, i)
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		key, err := p.mapKey(v.Type().Key(), i)
+		if err != nil {
+			return nil, err
+		}
+		child := v.MapIndex(key)
+		if !child.IsValid() {
+			return nil, p.errorAt(i, ErrPathNotFound, "missing key")
+		}
+		return p.lookup(child, i+1)
+	case reflect.Slice, reflect.Array:
+		index, err := p.sliceIndex(v, i)
+		if err != nil {
+			return nil, err
+		}
+		return p.lookup(v.Index(index), i+1)
+	case reflect.Struct:
+		field, err := p.structField(v, i)
+		if err != nil {
+			return nil, err
+		}
+		return p.lookup(field, i+1)
+	default:
+		return nil, p.errorAt(i, ErrPathNotFound, "cannot access %q on %s", segment, v.Type())
+	}
+}
+
+// lookupAll 对通配符匹配的所有子元素继续读取，不存在路径的子元素被跳过
+// 剩余路径中还有通配符时结果会被展开为一层
+func (p *dataPath) lookupAll(v reflect.Value, i int) (any, error) {
+	var children []reflect.Value
+	switch v.Kind() {
+	case reflect.Map:
+		children = sortedMapValues(v)
+	case reflect.Slice, reflect.Array:
+		for j := 0; j < v.Len(); j++ {
+			children = append(children, v.Index(j))
+		}
+	default:
+		return nil, p.errorAt(i, ErrPathNotFound, "wildcard requires a map or slice, got %s", v.Type())
+	}
+
+	flatten := p.hasWildcard(i + 1)
+	results := make([]any, 0, len(children))
+	for _, child := range children {
+		value, err := p.lookup(child, i+1)
+		if errors.Is(err, ErrPathNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if nested, ok := value.([]any); ok && flatten {
+			results = append(results, nested...)
+			continue
+		}
+		results = append(results, value)
+	}
+	return results, nil
+}
+
+// sortedMapValues 按键排序返回 map 的值，使通配符的结果顺序稳定
+func sortedMapValues(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		if r, ok := compareValues(a, b); ok && isOrderedKind(kindOf(a.Type())) {
+			return r
+		}
+		return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+	})
+	values := make([]reflect.Value, len(keys))
+	for i, key := range keys {
+		values[i] = m.MapIndex(key)
+	}
+	return values
+}
+
+// isOrderedKind 判断类别是否支持大小比较
+func isOrderedKind(k valueKind) bool {
+	return isNumeric(k) || k == kindString || k == kindTime
+}
+
+// mapKey 将第i个路径段转换为 map 的键，支持字符串、整数和接口类型的键
+func (p *dataPath) mapKey(keyType reflect.Type, i int) (reflect.Value, error) {
+	segment := p.segments[i]
+	if keyType.Kind() == reflect.Interface && reflect.TypeOf(segment).AssignableTo(keyType) {
+		return reflect.ValueOf(segment), nil
+	}
+	switch kindOf(keyType) {
+	case kindString:
+		return reflect.ValueOf(segment).Convert(keyType), nil
+	case kindInt:
+		n, err := strconv.ParseInt(segment, 10, 64)
+		if err == nil && !reflect.Zero(keyType).OverflowInt(n) {
+			return reflect.ValueOf(n).Convert(keyType), nil
+		}
+	case kindUint:
+		n, err := strconv.ParseUint(segment, 10, 64)
+		if err == nil && !reflect.Zero(keyType).OverflowUint(n) {
+			return reflect.ValueOf(n).Convert(keyType), nil
+		}
+	}
+	return reflect.Value{}, p.errorAt(i, ErrFieldType, "cannot use %q as %s key", segment, keyType)
+}
+
+// sliceIndex 将第i个路径段解析为切片或数组的索引
+func (p *dataPath) sliceIndex(v reflect.Value, i int) (int, error) {
+	index, err := strconv.Atoi(p.segments[i])
+	if err != nil {
+		return 0, p.errorAt(i, ErrPathNotFound, "%q is not an index", p.segments[i])
+	}
+	if index < 0 || index >= v.Len() {
+		return 0, p.errorAt(i, ErrPathNotFound, "index %d out of range [0, %d)", index, v.Len())
+	}
+	return index, nil
+}
+
+// structField 按Go字段名或json标签名获取结构体字段
+func (p *dataPath) structField(v reflect.Value, i int) (reflect.Value, error) {
+	field, ok := structFieldsOf(v.Type())[p.segments[i]]
+	if !ok {
+		return reflect.Value{}, p.errorAt(i, ErrPathNotFound, "%s has no field or json tag %q", v.Type(), p.segments[i])
+	}
+	f, err := v.FieldByIndexErr(field.index)
+	if err != nil {
+		return reflect.Value{}, p.errorAt(i, ErrPathNotFound, "nil embedded struct")
+	}
+	return f, nil
+}
+
+// DataGet 使用点路径读取嵌套的 map、切片、数组和结构体中的值，例如 "user.address.city"、"items.0.name"
+// 结构体字段可以使用Go字段名或json标签名，指针和接口会自动解引用
+// 路径段为 "*" 时匹配 map 或切片的所有元素并返回 []any（map 按键排序），不存在该路径的元素被跳过
+// 路径不存在时返回包装了 ErrPathNotFound 的 *FieldError
+func DataGet(item any, path string) (any, error) {
+	p := newDataPath(item, path)
+	return p.lookup(reflect.ValueOf(item), 0)
+}
+
+// DataGetOr 使用点路径读取值，路径不存在时返回 def
+func DataGetOr(item any, path string, def any) any {
+	value, err := DataGet(item, path)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// dataMutation 点路径写入操作，forget为true时删除路径上的值
+type dataMutation struct {
+	*dataPath
+	value  reflect.Value
+	forget bool
+}
+
+// mutate 从第i个路径段开始修改v（类型为t），返回修改后应写回父容器的值
+func (m *dataMutation) mutate(v reflect.Value, t reflect.Type, i int) (reflect.Value, error) {
+	if i == len(m.segments) {
+		return m.assign(t, i-1)
+	}
+	if !v.IsValid() {
+		v = reflect.Zero(t)
+	}
+	last := i == len(m.segments)-1
+	segment := m.segments[i]
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			if m.forget {
+				return reflect.Value{}, m.errorAt(i, ErrPathNotFound, "nil value")
+			}
+			v = reflect.ValueOf(map[string]any{})
+		} else {
+			v = v.Elem()
+		}
+		inner, err := m.mutate(v, v.Type(), i)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		wrapped := reflect.New(t).Elem()
+		wrapped.Set(inner)
+		return wrapped, nil
+	case reflect.Pointer:
+		if v.IsNil() {
+			if m.forget {
+				return reflect.Value{}, m.errorAt(i, ErrPathNotFound, "nil value")
+			}
+			v = reflect.New(t.Elem())
+		}
+		inner, err := m.mutate(v.Elem(), t.Elem(), i)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.Elem().Set(inner)
+		return v, nil
+	case reflect.Map:
+		return m.mutateMap(v, t, i, last)
+	case reflect.Slice:
+		if last && m.forget {
+			return m.forgetIndex(v, t, i)
+		}
+		return m.mutateElements(v, i)
+	case reflect.Array:
+		copied := reflect.New(t).Elem()
+		copied.Set(v)
+		if last && m.forget {
+			return m.eachIndex(copied, i, func(elem reflect.Value) error {
+				elem.SetZero()
+				return nil
+			})
+		}
+		return m.mutateElements(copied, i)
+	case reflect.Struct:
+		if !v.CanAddr() {
+			copied := reflect.New(t).Elem()
+			copied.Set(v)
+			v = copied
+		}
+		if segment == dataWildcard {
+			return reflect.Value{}, m.errorAt(i, ErrPathNotFound, "wildcard requires a map or slice, got %s", t)
+		}
+		field, err := m.structField(v, i)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if last && m.forget {
+			field.SetZero()
+			return v, nil
+		}
+		inner, err := m.mutate(field, field.Type(), i+1)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		field.Set(inner)
+		return v, nil
+	default:
+		return reflect.Value{}, m.errorAt(i, ErrPathNotFound, "cannot access %q on %s", segment, t)
+	}
+}
+
+// mutateMap 修改 map 中第i个路径段对应的键，map为nil时会被创建
+func (m *dataMutation) mutateMap(v reflect.Value, t reflect.Type, i int, last bool) (reflect.Value, error) {
+	if v.IsNil() {
+		if m.forget {
+			return reflect.Value{}, m.errorAt(i, ErrPathNotFound, "nil map")
+		}
+		v = reflect.MakeMap(t)
+	}
+
+	var keys []reflect.Value
+	wildcard := m.segments[i] == dataWildcard
+	if wildcard {
+		keys = v.MapKeys()
+	} else {
+		key, err := m.mapKey(t.Key(), i)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if m.forget && !v.MapIndex(key).IsValid() {
+			return reflect.Value{}, m.errorAt(i, ErrPathNotFound, "missing key")
+		}
+		keys = []reflect.Value{key}
+	}
+
+	for _, key := range keys {
+		if last && m.forget {
+			v.SetMapIndex(key, reflect.Value{})
+			continue
+		}
+		inner, err := m.mutate(v.MapIndex(key), t.Elem(), i+1)
+		if errors.Is(err, ErrPathNotFound) && wildcard {
+			continue
+		}
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetMapIndex(key, inner)
+	}
+	return v, nil
+}
+
+// mutateElements 修改切片或可寻址数组中第i个路径段对应的元素
+func (m *dataMutation) mutateElements(v reflect.Value, i int) (reflect.Value, error) {
+	wildcard := m.segments[i] == dataWildcard
+	return m.eachIndex(v, i, func(elem reflect.Value) error {
+		inner, err := m.mutate(elem, elem.Type(), i+1)
+		if errors.Is(err, ErrPathNotFound) && wildcard {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		elem.Set(inner)
+		return nil
+	})
+}
+
+// eachIndex 对第i个路径段匹配的每个元素调用fn
+func (m *dataMutation) eachIndex(v reflect.Value, i int, fn func(reflect.Value) error) (reflect.Value, error) {
+	if m.segments[i] == dataWildcard {
+		for j := 0; j < v.Len(); j++ {
+			if err := fn(v.Index(j)); err != nil {
+				return reflect.Value{}, err
+			}
+		}
+		return v, nil
+	}
+	index, err := m.sliceIndex(v, i)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if err := fn(v.Index(index)); err != nil {
+		return reflect.Value{}, err
+	}
+	return v, nil
+}
+
+// forgetIndex 从切片中删除第i个路径段对应的元素，返回新的切片，不修改原切片的底层数组
+func (m *dataMutation) forgetIndex(v reflect.Value, t reflect.Type, i int) (reflect.Value, error) {
+	if m.segments[i] == dataWildcard {
+		return reflect.MakeSlice(t, 0, 0), nil
+	}
+	index, err := m.sliceIndex(v, i)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	result := reflect.MakeSlice(t, 0, v.Len()-1)
+	result = reflect.AppendSlice(result, v.Slice(0, index))
+	return reflect.AppendSlice(result, v.Slice(index+1, v.Len())), nil
+}
+
+// assign 将写入的值转换为类型t，数值类型之间会自动转换（溢出或丢失小数部分时返回错误），t为指针时会分配新的指针
+func (m *dataMutation) assign(t reflect.Type, i int) (reflect.Value, error) {
+	if !m.value.IsValid() {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(t), nil
+		}
+		return reflect.Value{}, m.errorAt(i, ErrFieldType, "cannot assign nil to %s", t)
+	}
+	elem := t
+	if !convertibleType(m.value.Type(), t) && t.Kind() == reflect.Pointer {
+		elem = t.Elem()
+	}
+	if !convertibleType(m.value.Type(), elem) {
+		return reflect.Value{}, m.errorAt(i, ErrFieldType, "cannot assign %s to %s", m.value.Type(), t)
+	}
+	converted, err := convertValue(m.value, elem)
+	if err != nil {
+		return reflect.Value{}, m.errorAt(i, ErrFieldType, "%v", err)
+	}
+	if elem != t {
+		ptr := reflect.New(elem)
+		ptr.Elem().Set(converted)
+		return ptr, nil
+	}
+	return converted, nil
+}
+
+// apply 在target上执行写入操作，target必须是非nil的指针或 map
+func (m *dataMutation) apply(target any) error {
+	if len(m.segments) == 0 {
+		return &FieldError{Type: typeNameOf(m.root), Field: m.path, Err: ErrPathNotFound, Detail: "empty path"}
+	}
+	v := reflect.ValueOf(target)
+	switch {
+	case v.Kind() == reflect.Pointer && !v.IsNil():
+		inner, err := m.mutate(v.Elem(), v.Elem().Type(), 0)
+		if err != nil {
+			return err
+		}
+		v.Elem().Set(inner)
+		return nil
+	case v.Kind() == reflect.Map && !v.IsNil():
+		_, err := m.mutate(v, v.Type(), 0)
+		return err
+	default:
+		return &FieldError{Type: typeNameOf(m.root), Field: m.path, Err: ErrFieldType,
+			Detail: "target must be a non-nil pointer or map"}
+	}
+}
+
+// typeNameOf 返回类型名称，类型为nil时返回 "<nil>"
+func typeNameOf(t reflect.Type) string {
+	if t == nil {
+		return "<nil>"
+	}
+	return t.String()
+}
+
+// DataSet 使用点路径写入值，target必须是非nil的指针或 map
+// 路径上缺失的 map 和 nil 指针会被创建，any 类型的位置会创建 map[string]any
+// 路径段为 "*" 时写入 map 或切片的所有元素；切片索引越界时返回 ErrPathNotFound
+// 数值写入其他数值类型的位置时会自动转换，溢出或丢失小数部分时返回 ErrFieldType
+func DataSet(target any, path string, value any) error {
+	m := &dataMutation{dataPath: newDataPath(target, path), value: reflect.ValueOf(value)}
+	return m.apply(target)
+}
+
+// DataForget 使用点路径删除值：删除 map 的键、删除切片的元素，结构体字段和数组元素被置为零值
+// 路径不存在时返回 ErrPathNotFound，通配符匹配的元素中不存在该路径的会被跳过
+func DataForget(target any, path string) error {
+	m := &dataMutation{dataPath: newDataPath(target, path), forget: true}
+	return m.apply(target)
+}
+
+// pluckPath 读取每个元素上路径的值并转换为U，missing 处理路径不存在的元素
+func pluckPath[U any, T any](c *Collection[T], path string, missing func(i int, err error) (U, error)) (*Collection[U], error) {
+	target := reflect.TypeFor[U]()
+	result := make([]U, len(c.items))
+	for i, item := range c.items {
+		value, err := DataGet(item, path)
+		if err != nil {
+			if result[i], err = missing(i, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		v := reflect.ValueOf(value)
+		if v.IsValid() && !v.Type().AssignableTo(target) {
+			v, _ = indirect(v)
+		}
+		if !v.IsValid() {
+			continue
+		}
+		converted, err := convertValue(v, target)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: &FieldError{Type: typeNameOf(reflect.TypeOf(item)), Field: path,
+				Err: ErrFieldType, Detail: err.Error()}}
+		}
+		reflect.ValueOf(&result[i]).Elem().Set(converted)
+	}
+	return &Collection[U]{items: result}, nil
+}
+
+// PluckPath 使用点路径提取每个元素的值，数值类型之间会自动转换，值为nil时提取U的零值
+// 路径不存在、类型不兼容或数值转换溢出、丢失小数部分时返回包装了元素索引的 *IndexError
+func PluckPath[U any, T any](c *Collection[T], path string) (*Collection[U], error) {
+	return pluckPath(c, path, func(i int, err error) (U, error) {
+		var zero U
+		return zero, &IndexError{Index: i, Err: err}
+	})
+}
+
+// PluckPathOr 使用点路径提取每个元素的值，路径不存在时使用 def
+func PluckPathOr[U any, T any](c *Collection[T], path string, def U) (*Collection[U], error) {
+	return pluckPath(c, path, func(int, error) (U, error) {
+		return def, nil
+	})
+}
+
+// SortByPath 按点路径的值稳定排序，路径前加"-"表示降序，依次作为主、次排序键
+// 路径不存在或值为nil的元素无论升降序都排在最后，无法比较的值视为相等
+func SortByPath[T any](c *Collection[T], paths ...string) *Collection[T] {
+	sorter := &Sorter[T]{c: c, cached: true}
+	for _, path := range paths {
+		desc := strings.HasPrefix(path, "-")
+		sorter.levels = append(sorter.levels, newPathSortLevel[T](strings.TrimLeft(path, "+-"), desc))
+	}
+	return sorter.Collect()
+}
+
+// newPathSortLevel 创建按点路径的值排序的层级
+func newPathSortLevel[T any](path string, desc bool) sortLevel[T] {
+	sign := 1
+	if desc {
+		sign = -1
+	}
+	return func(items []T, _ bool) func(i, j int) int {
+		keys := make([]reflect.Value, len(items))
+		for i, item := range items {
+			if value, err := DataGet(item, path); err == nil {
+				keys[i], _ = indirect(reflect.ValueOf(value))
+			}
+		}
+		return func(i, j int) int {
+			a, b := keys[i], keys[j]
+			switch {
+			case !a.IsValid() && !b.IsValid():
+				return 0
+			case !a.IsValid():
+				return 1
+			case !b.IsValid():
+				return -1
+			}
+			return sign * orderValues(a, b)
+		}
+	}
+}
+
+// GroupByPath 按点路径的值分组，分组按首次出现的顺序排列，路径不存在的元素归入K的零值分组
+func GroupByPath[K comparable, T any](c *Collection[T], path string) (*MapCollection[K, *Collection[T]], error) {
+	var zero K
+	keys, err := PluckPathOr(c, path, zero)
+	if err != nil {
+		return nil, err
+	}
+	groups := NewMap[K, *Collection[T]]()
+	for i, item := range c.items {
+		key := keys.items[i]
+		group, ok := groups.Get(key)
+		if !ok {
+			group = New[T]()
+			groups.Put(key, group)
+		}
+		group.items = append(group.items, item)
+	}
+	return groups, nil
+}
@@ -0,0 +1,283 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func dataPayload() map[string]any {
+	return map[string]any{
+		"user": map[string]any{
+			"name": "Alice",
+			"address": map[string]any{
+				"city": "Beijing",
+			},
+			"tags": []any{"admin", "ops"},
+		},
+		"orders": []any{
+			map[string]any{"id": 1.0, "total": 30.5},
+			map[string]any{"id": 2.0},
+			map[string]any{"id": 3.0, "total": 12.0},
+		},
+	}
+}
+
+func TestDataGet(t *testing.T) {
+	payload := dataPayload()
+	tests := []struct {
+		path     string
+		expected any
+	}{
+		{"user.name", "Alice"},
+		{"user.address.city", "Beijing"},
+		{"user.tags.1", "ops"},
+		{"orders.0.total", 30.5},
+		{"orders.*.id", []any{1.0, 2.0, 3.0}},
+		{"orders.*.total", []any{30.5, 12.0}},
+		{"user.address.*", []any{"Beijing"}},
+		{"", payload},
+	}
+	for _, tt := range tests {
+		value, err := DataGet(payload, tt.path)
+		if err != nil {
+			t.Errorf("DataGet(%q): unexpected error %v", tt.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, tt.expected) {
+			t.Errorf("DataGet(%q): expected %v, got %v", tt.path, tt.expected, value)
+		}
+	}
+}
+
+func TestDataGetErrors(t *testing.T) {
+	payload := dataPayload()
+	for _, path := range []string{"user.email", "user.tags.5", "user.tags.x", "user.name.first", "missing.*.id"} {
+		_, err := DataGet(payload, path)
+		var fieldErr *FieldError
+		if !errors.Is(err, ErrPathNotFound) || !errors.As(err, &fieldErr) || fieldErr.Field != path {
+			t.Errorf("DataGet(%q): expected path not found error, got %v", path, err)
+		}
+	}
+	if value := DataGetOr(payload, "user.email", "n/a"); value != "n/a" {
+		t.Errorf("Expected default value, got %v", value)
+	}
+	if value := DataGetOr(payload, "user.name", "n/a"); value != "Alice" {
+		t.Errorf("Expected Alice, got %v", value)
+	}
+}
+
+func TestDataGetStruct(t *testing.T) {
+	users := whereFixtures()
+	if value, err := DataGet(users.All()[0], "address.city"); err != nil || value != "Beijing" {
+		t.Errorf("Expected Beijing, got %v (%v)", value, err)
+	}
+	if value, err := DataGet(&users.All()[0], "Address.Zip"); err != nil || *value.(*string) != "10001" {
+		t.Errorf("Expected zip pointer, got %v (%v)", value, err)
+	}
+	if _, err := DataGet(users.All()[2], "Address.City"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("Expected path not found for nil pointer, got %v", err)
+	}
+	nested := map[string][]whereUser{"team": users.All()}
+	if value, err := DataGet(nested, "team.*.id"); err != nil || !reflect.DeepEqual(value, []any{1, 2, 3, 4}) {
+		t.Errorf("Expected ids, got %v (%v)", value, err)
+	}
+}
+
+func TestDataSet(t *testing.T) {
+	payload := dataPayload()
+	if err := DataSet(payload, "user.address.zip", "100000"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := DataSet(payload, "user.profile.age", 30); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := DataSet(payload, "orders.*.paid", true); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := DataSet(payload, "user.tags.0", "root"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for path, expected := range map[string]any{
+		"user.address.zip": "100000",
+		"user.profile.age": 30,
+		"orders.2.paid":    true,
+		"user.tags.0":      "root",
+	} {
+		if value, err := DataGet(payload, path); err != nil || value != expected {
+			t.Errorf("DataGet(%q): expected %v, got %v (%v)", path, expected, value, err)
+		}
+	}
+	if err := DataSet(payload, "user.tags.9", "x"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("Expected path not found error, got %v", err)
+	}
+	if err := DataSet(payload, "user.name.first", "x"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("Expected path not found error, got %v", err)
+	}
+}
+
+func TestDataSetStruct(t *testing.T) {
+	user := whereUser{Name: "Carol"}
+	if err := DataSet(&user, "address.city", "Shenzhen"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if user.Address == nil || user.Address.City != "Shenzhen" {
+		t.Errorf("Expected address to be created, got %+v", user.Address)
+	}
+	if err := DataSet(&user, "Address.Zip", "518000"); err != nil || *user.Address.Zip != "518000" {
+		t.Errorf("Expected zip to be set, got %v", err)
+	}
+	if err := DataSet(&user, "age", 40); err != nil || user.Age != 40 {
+		t.Errorf("Expected age 40, got %d (%v)", user.Age, err)
+	}
+	if err := DataSet(&user, "id", 7); err != nil || user.ID != 7 {
+		t.Errorf("Expected embedded id 7, got %d (%v)", user.ID, err)
+	}
+	if err := DataSet(&user, "age", 300); !errors.Is(err, ErrFieldType) || user.Age != 40 {
+		t.Errorf("Expected overflow error, got %v (age %d)", err, user.Age)
+	}
+	if err := DataSet(&user, "id", 7.5); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected fraction error, got %v", err)
+	}
+	if err := DataSet(&user, "Name", 1.5); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+	if err := DataSet(user, "Name", "x"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected non-pointer target error, got %v", err)
+	}
+
+	users := map[string]whereUser{"a": {Name: "Alice"}}
+	if err := DataSet(users, "a.Name", "Alicia"); err != nil || users["a"].Name != "Alicia" {
+		t.Errorf("Expected struct in map to be updated, got %v (%v)", users["a"], err)
+	}
+}
+
+func TestDataForget(t *testing.T) {
+	payload := dataPayload()
+	if err := DataForget(payload, "user.address.city"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := DataForget(payload, "orders.*.total"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := DataForget(payload, "user.tags.0"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := DataGet(payload, "user.address.city"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("Expected city to be removed, got %v", err)
+	}
+	if value, _ := DataGet(payload, "orders.*.total"); len(value.([]any)) != 0 {
+		t.Errorf("Expected totals to be removed, got %v", value)
+	}
+	if value, _ := DataGet(payload, "user.tags"); !reflect.DeepEqual(value, []any{"ops"}) {
+		t.Errorf("Expected [ops], got %v", value)
+	}
+	if err := DataForget(payload, "user.email"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("Expected path not found error, got %v", err)
+	}
+
+	user := whereUser{Name: "Alice", Age: 30}
+	if err := DataForget(&user, "age"); err != nil || user.Age != 0 {
+		t.Errorf("Expected age to be reset, got %d (%v)", user.Age, err)
+	}
+}
+
+func TestPluckPath(t *testing.T) {
+	c := New(dataPayload(), map[string]any{"user": map[string]any{"name": "Bob"}})
+
+	names, err := PluckPath[string](c, "user.name")
+	if err != nil || !slices.Equal(names.All(), []string{"Alice", "Bob"}) {
+		t.Errorf("Expected [Alice Bob], got %v (%v)", names.All(), err)
+	}
+
+	_, err = PluckPath[string](c, "user.address.city")
+	var indexErr *IndexError
+	if !errors.Is(err, ErrPathNotFound) || !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected path not found error at index 1, got %v", err)
+	}
+
+	cities, err := PluckPathOr(c, "user.address.city", "unknown")
+	if err != nil || !slices.Equal(cities.All(), []string{"Beijing", "unknown"}) {
+		t.Errorf("Expected [Beijing unknown], got %v (%v)", cities.All(), err)
+	}
+
+	if _, err := PluckPath[int](c, "user.name"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+
+	orders := New(dataPayload()["orders"].([]any)...)
+	totals, err := PluckPathOr(orders, "total", 0.0)
+	if err != nil || !slices.Equal(totals.All(), []float64{30.5, 0, 12}) {
+		t.Errorf("Expected totals, got %v (%v)", totals.All(), err)
+	}
+	ids, err := PluckPath[int](orders, "id")
+	if err != nil || !slices.Equal(ids.All(), []int{1, 2, 3}) {
+		t.Errorf("Expected converted ids, got %v (%v)", ids.All(), err)
+	}
+	if _, err := PluckPathOr(orders, "total", 0); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected fraction error, got %v", err)
+	}
+}
+
+func TestPluckPathInterfaceNil(t *testing.T) {
+	c := New[any](map[string]any{"v": nil}, map[string]any{"v": 1}, whereUser{Name: "Carol"})
+
+	values, err := PluckPathOr[any](c, "v", "missing")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []any{nil, 1, "missing"}; !slices.Equal(values.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, values.All())
+	}
+
+	metas, err := PluckPath[any](New(whereUser{Name: "Carol"}), "Meta")
+	if err != nil || metas.Count() != 1 || metas.All()[0] != nil {
+		t.Errorf("Expected [<nil>], got %v (%v)", metas.All(), err)
+	}
+	addresses, err := PluckPath[fmt.Stringer](New(whereUser{Name: "Carol"}), "Meta")
+	if err != nil || addresses.Count() != 1 || addresses.All()[0] != nil {
+		t.Errorf("Expected nil Stringer, got %v (%v)", addresses.All(), err)
+	}
+}
+
+func TestSortByPath(t *testing.T) {
+	orders := New(dataPayload()["orders"].([]any)...)
+	ids := func(c *Collection[any]) []any {
+		return Map(c, func(o any) any { return DataGetOr(o, "id", nil) }).All()
+	}
+
+	if sorted := SortByPath(orders, "total"); !slices.Equal(ids(sorted), []any{3.0, 1.0, 2.0}) {
+		t.Errorf("Expected [3 1 2], got %v", ids(sorted))
+	}
+	if sorted := SortByPath(orders, "-total"); !slices.Equal(ids(sorted), []any{1.0, 3.0, 2.0}) {
+		t.Errorf("Expected [1 3 2], got %v", ids(sorted))
+	}
+	if sorted := SortByPath(orders, "-id"); !slices.Equal(ids(sorted), []any{3.0, 2.0, 1.0}) {
+		t.Errorf("Expected [3 2 1], got %v", ids(sorted))
+	}
+}
+
+func TestGroupByPath(t *testing.T) {
+	rows := New(
+		map[string]any{"name": "Alice", "address": map[string]any{"city": "Beijing"}},
+		map[string]any{"name": "Bob", "address": map[string]any{"city": "Shanghai"}},
+		map[string]any{"name": "Carol"},
+		map[string]any{"name": "Dave", "address": map[string]any{"city": "Beijing"}},
+	)
+	groups, err := GroupByPath[string](rows, "address.city")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := []string{"Beijing", "Shanghai", ""}; !slices.Equal(groups.Keys().All(), expected) {
+		t.Errorf("Expected keys %v, got %v", expected, groups.Keys().All())
+	}
+	if beijing, _ := groups.Get("Beijing"); beijing.Count() != 2 {
+		t.Errorf("Expected 2 rows in Beijing, got %d", beijing.Count())
+	}
+
+	if _, err := GroupByPath[int](rows, "name"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+}
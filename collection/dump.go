@@ -0,0 +1,371 @@
+package collection
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultDumpDepth MaxDepth 为0时使用的默认最大嵌套深度
+	DefaultDumpDepth = 10
+	// DefaultDumpLength MaxLength 为0时每个切片、数组或 map 默认最多输出的元素数量
+	DefaultDumpLength = 100
+	// DefaultDumpStringLength MaxStringLength 为0时字符串默认最多输出的字符数量
+	DefaultDumpStringLength = 200
+)
+
+// DumpOptions 调试输出选项，零值使用默认的限制；MaxDepth、MaxLength、MaxStringLength 为负数时不限制
+type DumpOptions struct {
+	// MaxDepth 最大嵌套深度，超出的结构体、切片和 map 输出为 {...} 或 [...]
+	MaxDepth int
+	// MaxLength 每个切片、数组或 map 最多输出的元素数量
+	MaxLength int
+	// MaxStringLength 字符串最多输出的字符数量
+	MaxStringLength int
+	// Indent 每层缩进，为空时使用两个空格
+	Indent string
+	// DisableMethods 不使用 error 和 fmt.Stringer 接口输出值，总是展开结构体字段
+	DisableMethods bool
+}
+
+// dumpLimit 返回限制值，0使用默认值，负数表示不限制
+func dumpLimit(value, def int) int {
+	switch {
+	case value == 0:
+		return def
+	case value < 0:
+		return int(^uint(0) >> 1)
+	default:
+		return value
+	}
+}
+
+// dumper 调试输出的状态，visiting 记录当前路径上的指针、切片和 map 以检测循环引用
+type dumper struct {
+	w               *bufio.Writer
+	maxDepth        int
+	maxLength       int
+	maxStringLength int
+	indent          string
+	disableMethods  bool
+	visiting        map[dumpVisit]bool
+}
+
+// dumpVisit 循环检测的键
+type dumpVisit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// newDumper 根据选项创建 dumper
+func newDumper(w io.Writer, opts DumpOptions) *dumper {
+	indent := opts.Indent
+	if indent == "" {
+		indent = "  "
+	}
+	return &dumper{
+		w:               bufio.NewWriter(w),
+		maxDepth:        dumpLimit(opts.MaxDepth, DefaultDumpDepth),
+		maxLength:       dumpLimit(opts.MaxLength, DefaultDumpLength),
+		maxStringLength: dumpLimit(opts.MaxStringLength, DefaultDumpStringLength),
+		indent:          indent,
+		disableMethods:  opts.DisableMethods,
+		visiting:        make(map[dumpVisit]bool),
+	}
+}
+
+// newline 换行并缩进到depth层
+func (d *dumper) newline(depth int) {
+	d.w.WriteByte('\n')
+	d.w.WriteString(strings.Repeat(d.indent, depth))
+}
+
+// value 输出带类型的值，例如 (int) 1
+func (d *dumper) value(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.w.WriteString("nil")
+		return
+	}
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			d.w.WriteString("(" + v.Type().String() + ") nil")
+			return
+		}
+		v = v.Elem()
+	}
+	d.w.WriteString("(" + v.Type().String() + ") ")
+	d.body(v, depth)
+}
+
+// body 输出不带类型的值
+func (d *dumper) body(v reflect.Value, depth int) {
+	if d.method(v) {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			d.w.WriteString("nil")
+			return
+		}
+		if d.enter(v) {
+			return
+		}
+		defer d.leave(v)
+		d.body(v.Elem(), depth)
+	case reflect.Interface:
+		if v.IsNil() {
+			d.w.WriteString("nil")
+			return
+		}
+		d.value(v.Elem(), depth)
+	case reflect.Struct:
+		d.structBody(v, depth)
+	case reflect.Slice:
+		if v.IsNil() {
+			d.w.WriteString("nil")
+			return
+		}
+		if d.enter(v) {
+			return
+		}
+		defer d.leave(v)
+		d.listBody(v, depth)
+	case reflect.Array:
+		d.listBody(v, depth)
+	case reflect.Map:
+		if v.IsNil() {
+			d.w.WriteString("nil")
+			return
+		}
+		if d.enter(v) {
+			return
+		}
+		defer d.leave(v)
+		d.mapBody(v, depth)
+	default:
+		d.w.WriteString(d.scalar(v))
+	}
+}
+
+// method 使用 error 或 fmt.Stringer 接口输出值，返回是否已输出
+func (d *dumper) method(v reflect.Value) bool {
+	if d.disableMethods || !v.CanInterface() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return false
+	}
+	switch value := v.Interface().(type) {
+	case error:
+		if text, ok := callMethod(value.Error); ok {
+			d.w.WriteString(d.quote(text))
+		} else {
+			d.w.WriteString(text)
+		}
+		return true
+	case fmt.Stringer:
+		text, _ := callMethod(value.String)
+		d.w.WriteString(text)
+		return true
+	}
+	return false
+}
+
+// callMethod 调用 Error 或 String 方法，方法panic时与 fmt 一样恢复并返回 <panic: ...>，ok为false
+func callMethod(fn func() string) (text string, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			text, ok = fmt.Sprintf("<panic: %v>", r), false
+		}
+	}()
+	return fn(), true
+}
+
+// enter 记录进入引用类型的值，检测到循环引用时输出 <cycle> 并返回true
+func (d *dumper) enter(v reflect.Value) bool {
+	key := dumpVisit{ptr: v.Pointer(), typ: v.Type()}
+	if d.visiting[key] {
+		d.w.WriteString("<cycle>")
+		return true
+	}
+	d.visiting[key] = true
+	return false
+}
+
+// leave 记录离开引用类型的值
+func (d *dumper) leave(v reflect.Value) {
+	delete(d.visiting, dumpVisit{ptr: v.Pointer(), typ: v.Type()})
+}
+
+// structBody 输出结构体的所有字段（包括未导出的字段）
+func (d *dumper) structBody(v reflect.Value, depth int) {
+	if v.NumField() == 0 {
+		d.w.WriteString("{}")
+		return
+	}
+	if depth >= d.maxDepth {
+		d.w.WriteString("{...}")
+		return
+	}
+	d.w.WriteByte('{')
+	for i := 0; i < v.NumField(); i++ {
+		d.newline(depth + 1)
+		d.w.WriteString(v.Type().Field(i).Name + ": ")
+		d.value(v.Field(i), depth+1)
+	}
+	d.newline(depth)
+	d.w.WriteByte('}')
+}
+
+// listBody 输出切片或数组的元素及索引
+func (d *dumper) listBody(v reflect.Value, depth int) {
+	d.w.WriteString("(len=" + strconv.Itoa(v.Len()) + ") ")
+	if v.Len() == 0 {
+		d.w.WriteString("[]")
+		return
+	}
+	if depth >= d.maxDepth {
+		d.w.WriteString("[...]")
+		return
+	}
+	d.w.WriteByte('[')
+	for i := 0; i < v.Len(); i++ {
+		if i == d.maxLength {
+			d.newline(depth + 1)
+			d.w.WriteString(fmt.Sprintf("... (%d more)", v.Len()-i))
+			break
+		}
+		d.newline(depth + 1)
+		d.w.WriteString(strconv.Itoa(i) + ": ")
+		d.value(v.Index(i), depth+1)
+	}
+	d.newline(depth)
+	d.w.WriteByte(']')
+}
+
+// mapBody 输出 map 的键值对，键按顺序排列
+func (d *dumper) mapBody(v reflect.Value, depth int) {
+	d.w.WriteString("(len=" + strconv.Itoa(v.Len()) + ") ")
+	if v.Len() == 0 {
+		d.w.WriteString("{}")
+		return
+	}
+	if depth >= d.maxDepth {
+		d.w.WriteString("{...}")
+		return
+	}
+
+	keys := v.MapKeys()
+	labels := make(map[reflect.Value]string, len(keys))
+	for _, key := range keys {
+		k, _ := indirect(key)
+		if k.IsValid() && (k.Kind() == reflect.String || isNumeric(kindOf(k.Type())) || k.Kind() == reflect.Bool) {
+			labels[key] = d.scalar(k)
+		} else {
+			labels[key] = d.capture(key, depth+1)
+		}
+	}
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		ka, _ := indirect(a)
+		kb, _ := indirect(b)
+		if ka.IsValid() && kb.IsValid() && isOrderedKind(kindOf(ka.Type())) {
+			if r, ok := compareValues(ka, kb); ok {
+				return r
+			}
+		}
+		return cmp.Compare(labels[a], labels[b])
+	})
+
+	d.w.WriteByte('{')
+	for i, key := range keys {
+		if i == d.maxLength {
+			d.newline(depth + 1)
+			d.w.WriteString(fmt.Sprintf("... (%d more)", len(keys)-i))
+			break
+		}
+		d.newline(depth + 1)
+		d.w.WriteString(labels[key] + ": ")
+		d.value(v.MapIndex(key), depth+1)
+	}
+	d.newline(depth)
+	d.w.WriteByte('}')
+}
+
+// capture 将值的输出作为字符串返回，用于输出 map 的复杂键
+func (d *dumper) capture(v reflect.Value, depth int) string {
+	var b strings.Builder
+	inner := *d
+	inner.w = bufio.NewWriter(&b)
+	inner.body(v, depth)
+	inner.w.Flush()
+	return b.String()
+}
+
+// scalar 格式化基本类型的值，不调用 Interface 以支持未导出的字段
+func (d *dumper) scalar(v reflect.Value) string {
+	switch kindOf(v.Type()) {
+	case kindInt:
+		return strconv.FormatInt(v.Int(), 10)
+	case kindUint:
+		return strconv.FormatUint(v.Uint(), 10)
+	case kindFloat:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case kindBool:
+		return strconv.FormatBool(v.Bool())
+	case kindString:
+		return d.quote(v.String())
+	}
+	switch v.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			return "nil"
+		}
+		return "<" + v.Kind().String() + ">"
+	default:
+		return "<" + v.Kind().String() + ">"
+	}
+}
+
+// quote 为字符串加引号，超出 maxStringLength 的部分被截断并输出原始长度
+func (d *dumper) quote(s string) string {
+	if utf8.RuneCountInString(s) <= d.maxStringLength {
+		return strconv.Quote(s)
+	}
+	runes := []rune(s)
+	return strconv.Quote(string(runes[:d.maxStringLength])) + fmt.Sprintf("... (len=%d)", len(s))
+}
+
+// Dump 以缩进格式输出集合的调试信息：元素类型、索引，递归展开结构体、指针、切片和 map
+// 循环引用输出为 <cycle>，可以通过 opts 限制嵌套深度、元素数量和字符串长度
+func (c *Collection[T]) Dump(w io.Writer, opts DumpOptions) error {
+	d := newDumper(w, opts)
+	d.w.WriteString("(*collection.Collection[" + reflect.TypeFor[T]().String() + "]) ")
+	d.listBody(reflect.ValueOf(c.items), 0)
+	d.w.WriteByte('\n')
+	return d.w.Flush()
+}
+
+// DumpString 返回 Dump 的输出
+func (c *Collection[T]) DumpString(opts DumpOptions) string {
+	var b strings.Builder
+	c.Dump(&b, opts)
+	return b.String()
+}
+
+// DumpTap 将调试信息输出到w（为nil时输出到标准错误）并返回集合本身，可以插入链式调用的任意位置
+func (c *Collection[T]) DumpTap(w io.Writer, opts DumpOptions) *Collection[T] {
+	if w == nil {
+		w = os.Stderr
+	}
+	c.Dump(w, opts)
+	return c
+}
@@ -0,0 +1,129 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type dumpAddress struct {
+	City string
+}
+
+type dumpNode struct {
+	Name     string
+	Age      int
+	Address  *dumpAddress
+	Tags     []string
+	Meta     map[string]any
+	Next     *dumpNode
+	password string
+}
+
+type dumpPanicker struct{}
+
+func (dumpPanicker) String() string { panic("bad stringer") }
+
+func TestDump(t *testing.T) {
+	nodes := New(
+		dumpNode{Name: "Alice", Age: 30, Address: &dumpAddress{"Beijing"}, Tags: []string{"a"},
+			Meta: map[string]any{"b": 2, "a": nil}, password: "secret"},
+	)
+	expected := `(*collection.Collection[collection.dumpNode]) (len=1) [
+  0: (collection.dumpNode) {
+    Name: (string) "Alice"
+    Age: (int) 30
+    Address: (*collection.dumpAddress) {
+      City: (string) "Beijing"
+    }
+    Tags: ([]string) (len=1) [
+      0: (string) "a"
+    ]
+    Meta: (map[string]interface {}) (len=2) {
+      "a": (interface {}) nil
+      "b": (int) 2
+    }
+    Next: (*collection.dumpNode) nil
+    password: (string) "secret"
+  }
+]
+`
+	if got := nodes.DumpString(DumpOptions{}); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestDumpCycle(t *testing.T) {
+	node := &dumpNode{Name: "loop"}
+	node.Next = node
+	got := New(node).DumpString(DumpOptions{})
+	if !strings.Contains(got, "Next: (*collection.dumpNode) <cycle>") {
+		t.Errorf("Expected cycle marker, got:\n%s", got)
+	}
+
+	list := []any{1, nil}
+	list[1] = list
+	got = New(list).DumpString(DumpOptions{})
+	if !strings.Contains(got, "1: ([]interface {}) <cycle>") {
+		t.Errorf("Expected slice cycle marker, got:\n%s", got)
+	}
+
+	shared := &dumpAddress{"Shanghai"}
+	got = New(shared, shared).DumpString(DumpOptions{})
+	if strings.Contains(got, "<cycle>") {
+		t.Errorf("Expected shared pointers not to be reported as cycles, got:\n%s", got)
+	}
+}
+
+func TestDumpLimits(t *testing.T) {
+	got := New(1, 2, 3, 4, 5).DumpString(DumpOptions{MaxLength: 2})
+	expected := "(*collection.Collection[int]) (len=5) [\n  0: (int) 1\n  1: (int) 2\n  ... (3 more)\n]\n"
+	if got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	got = New(strings.Repeat("x", 10)).DumpString(DumpOptions{MaxStringLength: 3, Indent: "\t"})
+	if !strings.Contains(got, "\t0: (string) \"xxx\"... (len=10)") {
+		t.Errorf("Expected truncated string, got:\n%s", got)
+	}
+
+	nested := New([][]int{{1}})
+	got = nested.DumpString(DumpOptions{MaxDepth: 1})
+	if !strings.Contains(got, "0: ([][]int) (len=1) [...]") {
+		t.Errorf("Expected depth limit, got:\n%s", got)
+	}
+
+	if got := New[int]().DumpString(DumpOptions{}); got != "(*collection.Collection[int]) (len=0) []\n" {
+		t.Errorf("Unexpected empty dump %q", got)
+	}
+}
+
+func TestDumpMethods(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	got := New[any](at, errors.New("boom")).DumpString(DumpOptions{})
+	if !strings.Contains(got, "0: (time.Time) 2024-01-02 03:04:05 +0000 UTC") || !strings.Contains(got, `1: (*errors.errorString) "boom"`) {
+		t.Errorf("Expected Stringer and error output, got:\n%s", got)
+	}
+
+	got = New(dumpAddress{"x"}).DumpString(DumpOptions{DisableMethods: true})
+	if !strings.Contains(got, `City: (string) "x"`) {
+		t.Errorf("Expected fields, got:\n%s", got)
+	}
+}
+
+func TestDumpPanickingStringer(t *testing.T) {
+	got := New[any](dumpPanicker{}, 1).DumpString(DumpOptions{})
+	if !strings.Contains(got, "0: (collection.dumpPanicker) <panic: bad stringer>") || !strings.Contains(got, "1: (int) 1") {
+		t.Errorf("Expected recovered panic, got:\n%s", got)
+	}
+}
+
+func TestDumpTap(t *testing.T) {
+	var buf bytes.Buffer
+	result := New(3, 1, 2).DumpTap(&buf, DumpOptions{}).Filter(func(n int) bool { return n > 1 })
+	if result.Count() != 2 || !strings.Contains(buf.String(), "(len=3)") {
+		t.Errorf("Expected chain to continue after dump, got %v and %q", result.All(), buf.String())
+	}
+}
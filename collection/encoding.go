@@ -0,0 +1,73 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON 实现json.Marshaler接口，集合编码为JSON数组，空集合编码为[]
+// 使用值接收者，使作为值类型字段嵌入结构体的集合也能被正确编码
+func (c Collection[T]) MarshalJSON() ([]byte, error) {
+	if c.items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(c.items)
+}
+
+// UnmarshalJSON 实现json.Unmarshaler接口，null 解码为空集合
+// 作为 *Collection[T] 类型的字段时，null 和缺失的字段由 encoding/json 处理为nil指针，需要空集合时请使用值类型字段
+func (c *Collection[T]) UnmarshalJSON(data []byte) error {
+	items := make([]T, 0)
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		items = make([]T, 0)
+	}
+	c.items = items
+	return nil
+}
+
+// MarshalText 实现encoding.TextMarshaler接口，输出与 MarshalJSON 相同
+func (c Collection[T]) MarshalText() ([]byte, error) {
+	return c.MarshalJSON()
+}
+
+// UnmarshalText 实现encoding.TextUnmarshaler接口，输入为JSON数组
+func (c *Collection[T]) UnmarshalText(text []byte) error {
+	return c.UnmarshalJSON(text)
+}
+
+// GobEncode 实现gob.GobEncoder接口，元素中的接口类型需要先通过 gob.Register 注册
+func (c Collection[T]) GobEncode() ([]byte, error) {
+	items := c.items
+	if items == nil {
+		items = make([]T, 0)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(items); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode 实现gob.GobDecoder接口
+func (c *Collection[T]) GobDecode(data []byte) error {
+	items := make([]T, 0)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+		return err
+	}
+	c.items = items
+	return nil
+}
+
+// MarshalBinary 实现encoding.BinaryMarshaler接口，使用gob编码，适用于缓存等只接受二进制数据的场景
+func (c Collection[T]) MarshalBinary() ([]byte, error) {
+	return c.GobEncode()
+}
+
+// UnmarshalBinary 实现encoding.BinaryUnmarshaler接口
+func (c *Collection[T]) UnmarshalBinary(data []byte) error {
+	return c.GobDecode(data)
+}
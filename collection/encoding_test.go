@@ -0,0 +1,123 @@
+package collection
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+type encodingTeam struct {
+	Name    string              `json:"name"`
+	Members *Collection[string] `json:"members"`
+	Scores  Collection[int]     `json:"scores"`
+}
+
+var (
+	_ json.Marshaler             = Collection[int]{}
+	_ json.Unmarshaler           = (*Collection[int])(nil)
+	_ encoding.TextMarshaler     = Collection[int]{}
+	_ encoding.TextUnmarshaler   = (*Collection[int])(nil)
+	_ encoding.BinaryMarshaler   = Collection[int]{}
+	_ encoding.BinaryUnmarshaler = (*Collection[int])(nil)
+	_ gob.GobEncoder             = Collection[int]{}
+	_ gob.GobDecoder             = (*Collection[int])(nil)
+)
+
+func TestCollectionMarshalJSON(t *testing.T) {
+	team := encodingTeam{Name: "core", Members: New("Alice", "Bob"), Scores: *New(1, 2)}
+	data, err := json.Marshal(team)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"name":"core","members":["Alice","Bob"],"scores":[1,2]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	data, _ = json.Marshal(encodingTeam{Name: "empty", Members: &Collection[string]{}})
+	if expected := `{"name":"empty","members":[],"scores":[]}`; string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	if jsonStr, _ := (&Collection[string]{}).ToJSON(); jsonStr != "[]" {
+		t.Errorf("Expected ToJSON to agree with MarshalJSON, got %s", jsonStr)
+	}
+}
+
+func TestCollectionUnmarshalJSON(t *testing.T) {
+	var team encodingTeam
+	if err := json.Unmarshal([]byte(`{"name":"core","members":["Alice","Bob"],"scores":[3,4]}`), &team); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !slices.Equal(team.Members.All(), []string{"Alice", "Bob"}) || !slices.Equal(team.Scores.All(), []int{3, 4}) {
+		t.Errorf("Unexpected team %+v", team)
+	}
+
+	var empty encodingTeam
+	if err := json.Unmarshal([]byte(`{"scores":null}`), &empty); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if empty.Scores.All() == nil || empty.Scores.Count() != 0 {
+		t.Errorf("Expected null to decode to empty collection, got %v", empty.Scores.All())
+	}
+
+	c := New(9)
+	if err := json.Unmarshal([]byte(`null`), c); err != nil || c.All() == nil || c.Count() != 0 {
+		t.Errorf("Expected null to reset collection, got %v (%v)", c.All(), err)
+	}
+	if err := json.Unmarshal([]byte(`{"scores":"x"}`), &empty); err == nil {
+		t.Error("Expected error for invalid input")
+	}
+}
+
+func TestCollectionText(t *testing.T) {
+	text, err := New(1, 2, 3).MarshalText()
+	if err != nil || string(text) != "[1,2,3]" {
+		t.Errorf("Expected [1,2,3], got %s (%v)", text, err)
+	}
+	var c Collection[int]
+	if err := c.UnmarshalText(text); err != nil || !slices.Equal(c.All(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v (%v)", c.All(), err)
+	}
+}
+
+func TestCollectionGob(t *testing.T) {
+	team := encodingTeam{Name: "core", Members: New("Alice", "Bob"), Scores: *New(1, 2)}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(team); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded encodingTeam
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Name != "core" || !slices.Equal(decoded.Members.All(), []string{"Alice", "Bob"}) ||
+		!slices.Equal(decoded.Scores.All(), []int{1, 2}) {
+		t.Errorf("Unexpected decoded team %+v", decoded)
+	}
+}
+
+func TestCollectionBinary(t *testing.T) {
+	data, err := New("a", "b").MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var c Collection[string]
+	if err := c.UnmarshalBinary(data); err != nil || !slices.Equal(c.All(), []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v (%v)", c.All(), err)
+	}
+
+	data, err = (&Collection[string]{}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := c.UnmarshalBinary(data); err != nil || c.Count() != 0 {
+		t.Errorf("Expected empty collection, got %v (%v)", c.All(), err)
+	}
+	if err := c.UnmarshalBinary([]byte("garbage")); err == nil {
+		t.Error("Expected error for invalid input")
+	}
+}
@@ -0,0 +1,139 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorMode 控制可失败回调遇到错误时的处理方式
+type ErrorMode int
+
+const (
+	// StopOnError 遇到第一个错误时立即停止
+	StopOnError ErrorMode = iota
+	// CollectErrors 继续处理所有元素，并通过 errors.Join 合并所有错误
+	CollectErrors
+)
+
+// IndexError 记录回调失败的元素索引
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error 实现error接口
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("collection: index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap 返回原始错误
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// errorCollector 根据ErrorMode收集错误
+type errorCollector struct {
+	mode ErrorMode
+	errs []error
+}
+
+// add 记录索引i处的错误，返回是否应当停止
+func (ec *errorCollector) add(i int, err error) bool {
+	ec.errs = append(ec.errs, &IndexError{Index: i, Err: err})
+	return ec.mode == StopOnError
+}
+
+// err 返回合并后的错误
+func (ec *errorCollector) err() error {
+	if len(ec.errs) == 1 {
+		return ec.errs[0]
+	}
+	return errors.Join(ec.errs...)
+}
+
+// MapErr 对集合中的每个元素应用可失败的回调函数
+// StopOnError 模式下出错时返回nil集合；CollectErrors 模式下返回成功映射的元素及合并后的错误
+func MapErr[T, U any](c *Collection[T], fn func(T) (U, error), mode ErrorMode) (*Collection[U], error) {
+	ec := errorCollector{mode: mode}
+	mapped := make([]U, 0, len(c.items))
+	for i, item := range c.items {
+		value, err := fn(item)
+		if err != nil {
+			if ec.add(i, err) {
+				return nil, ec.err()
+			}
+			continue
+		}
+		mapped = append(mapped, value)
+	}
+	return &Collection[U]{items: mapped}, ec.err()
+}
+
+// FilterErr 根据可失败的回调函数过滤集合
+// StopOnError 模式下出错时返回nil集合；CollectErrors 模式下出错的元素被排除
+func (c *Collection[T]) FilterErr(fn func(T) (bool, error), mode ErrorMode) (*Collection[T], error) {
+	ec := errorCollector{mode: mode}
+	filtered := make([]T, 0)
+	for i, item := range c.items {
+		keep, err := fn(item)
+		if err != nil {
+			if ec.add(i, err) {
+				return nil, ec.err()
+			}
+			continue
+		}
+		if keep {
+			filtered = append(filtered, item)
+		}
+	}
+	return &Collection[T]{items: filtered}, ec.err()
+}
+
+// EachErr 使用可失败的回调函数遍历集合中的每个元素
+func (c *Collection[T]) EachErr(fn func(T) error, mode ErrorMode) error {
+	ec := errorCollector{mode: mode}
+	for i, item := range c.items {
+		if err := fn(item); err != nil {
+			if ec.add(i, err) {
+				break
+			}
+		}
+	}
+	return ec.err()
+}
+
+// ReduceErr 使用可失败的回调函数将集合缩减为单个值
+// 出错的元素不会改变累积值；StopOnError 模式下返回出错前的累积值
+func ReduceErr[T, U any](c *Collection[T], fn func(U, T) (U, error), initial U, mode ErrorMode) (U, error) {
+	ec := errorCollector{mode: mode}
+	result := initial
+	for i, item := range c.items {
+		next, err := fn(result, item)
+		if err != nil {
+			if ec.add(i, err) {
+				break
+			}
+			continue
+		}
+		result = next
+	}
+	return result, ec.err()
+}
+
+// FlatMapErr 对集合应用可失败的映射函数，然后扁平化结果
+// StopOnError 模式下出错时返回nil集合；CollectErrors 模式下返回成功映射的元素及合并后的错误
+func FlatMapErr[T, U any](c *Collection[T], fn func(T) ([]U, error), mode ErrorMode) (*Collection[U], error) {
+	ec := errorCollector{mode: mode}
+	flattened := make([]U, 0)
+	for i, item := range c.items {
+		values, err := fn(item)
+		if err != nil {
+			if ec.add(i, err) {
+				return nil, ec.err()
+			}
+			continue
+		}
+		flattened = append(flattened, values...)
+	}
+	return &Collection[U]{items: flattened}, ec.err()
+}
@@ -0,0 +1,117 @@
+package collection
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	c := New("1", "2", "3")
+	mapped, err := MapErr(c, strconv.Atoi, StopOnError)
+	if err != nil {
+		t.Fatalf("MapErr failed: %v", err)
+	}
+	if !slices.Equal(mapped.All(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", mapped.All())
+	}
+}
+
+func TestMapErrStopOnError(t *testing.T) {
+	calls := 0
+	c := New("1", "x", "3", "y")
+	mapped, err := MapErr(c, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	}, StopOnError)
+	if mapped != nil {
+		t.Errorf("Expected nil collection, got %v", mapped.All())
+	}
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected IndexError at index 1, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+}
+
+func TestMapErrCollectErrors(t *testing.T) {
+	c := New("1", "x", "3", "y")
+	mapped, err := MapErr(c, strconv.Atoi, CollectErrors)
+	if !slices.Equal(mapped.All(), []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", mapped.All())
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected wrapped ErrSyntax, got %v", err)
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 2 {
+		t.Fatalf("Expected 2 joined errors, got %v", err)
+	}
+	if joined.Unwrap()[1].(*IndexError).Index != 3 {
+		t.Errorf("Expected second error at index 3, got %v", joined.Unwrap()[1])
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	bad := errors.New("bad")
+	c := New(1, 2, 3, 4)
+	filtered, err := c.FilterErr(func(n int) (bool, error) {
+		if n == 3 {
+			return false, bad
+		}
+		return n%2 == 0, nil
+	}, CollectErrors)
+	if !errors.Is(err, bad) {
+		t.Errorf("Expected bad error, got %v", err)
+	}
+	if !slices.Equal(filtered.All(), []int{2, 4}) {
+		t.Errorf("Expected [2 4], got %v", filtered.All())
+	}
+}
+
+func TestEachErr(t *testing.T) {
+	bad := errors.New("bad")
+	visited := make([]int, 0)
+	err := New(1, 2, 3).EachErr(func(n int) error {
+		visited = append(visited, n)
+		if n == 2 {
+			return bad
+		}
+		return nil
+	}, StopOnError)
+	if !errors.Is(err, bad) || !slices.Equal(visited, []int{1, 2}) {
+		t.Errorf("Expected stop at 2, got %v visited %v", err, visited)
+	}
+}
+
+func TestReduceErr(t *testing.T) {
+	c := New("1", "x", "3")
+	sum, err := ReduceErr(c, func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}, 0, CollectErrors)
+	if sum != 4 || err == nil {
+		t.Errorf("Expected sum 4 with error, got %d %v", sum, err)
+	}
+
+	sum, err = ReduceErr(c, func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}, 0, StopOnError)
+	if sum != 1 || err == nil {
+		t.Errorf("Expected sum 1 with error, got %d %v", sum, err)
+	}
+}
+
+func TestFlatMapErr(t *testing.T) {
+	c := New(1, 2, 3)
+	result, err := FlatMapErr(c, func(n int) ([]int, error) {
+		return []int{n, n * 10}, nil
+	}, StopOnError)
+	if err != nil || !slices.Equal(result.All(), []int{1, 10, 2, 20, 3, 30}) {
+		t.Errorf("FlatMapErr failed: %v %v", result.All(), err)
+	}
+}
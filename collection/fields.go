@@ -0,0 +1,179 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrUnknownField 字段不存在
+	ErrUnknownField = errors.New("collection: unknown field")
+	// ErrFieldType 字段类型与给定的值或目标类型不兼容
+	ErrFieldType = errors.New("collection: incompatible field type")
+)
+
+// FieldError 描述字段解析或类型检查失败的原因
+type FieldError struct {
+	Type   string
+	Field  string
+	Err    error
+	Detail string
+}
+
+// Error 实现error接口
+func (e *FieldError) Error() string {
+	msg := fmt.Sprintf("%v: %q on %s", e.Err, e.Field, e.Type)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// Unwrap 返回 ErrUnknownField 或 ErrFieldType
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// structField 结构体字段的元数据，index 为从外层结构体到字段的索引路径（包括嵌入的结构体）
+type structField struct {
+	index []int
+	typ   reflect.Type
+}
+
+// structFieldCache 缓存每个结构体类型按Go字段名和json标签名索引的字段
+var structFieldCache sync.Map // map[reflect.Type]map[string]*structField
+
+// structFieldsOf 返回结构体类型的字段表，Go字段名优先于json标签名，浅层字段优先于嵌入的深层字段
+func structFieldsOf(t reflect.Type) map[string]*structField {
+	if cached, ok := structFieldCache.Load(t); ok {
+		return cached.(map[string]*structField)
+	}
+
+	byGoName := make(map[string]*structField)
+	byTag := make(map[string]*structField)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() {
+			continue
+		}
+		field := &structField{index: f.Index, typ: f.Type}
+		if existing, ok := byGoName[f.Name]; !ok || len(f.Index) < len(existing.index) {
+			byGoName[f.Name] = field
+		}
+		if name := jsonFieldName(f); name != "" {
+			if existing, ok := byTag[name]; !ok || len(f.Index) < len(existing.index) {
+				byTag[name] = field
+			}
+		}
+	}
+
+	fields := make(map[string]*structField, len(byGoName)+len(byTag))
+	for name, field := range byTag {
+		fields[name] = field
+	}
+	for name, field := range byGoName {
+		fields[name] = field
+	}
+	actual, _ := structFieldCache.LoadOrStore(t, fields)
+	return actual.(map[string]*structField)
+}
+
+// jsonFieldName 返回json标签中的字段名，没有标签或标签为"-"时返回空字符串
+func jsonFieldName(f reflect.StructField) string {
+	tag, ok := f.Tag.Lookup("json")
+	if !ok || tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// fieldPath 解析后的字段路径，例如 Address.City
+type fieldPath struct {
+	path  string
+	steps [][]int
+	typ   reflect.Type
+}
+
+// fieldPathKey 字段路径缓存的键
+type fieldPathKey struct {
+	typ  reflect.Type
+	path string
+}
+
+// fieldPathCache 缓存已解析的字段路径
+var fieldPathCache sync.Map // map[fieldPathKey]*fieldPath
+
+// resolveFieldPath 在类型t上解析以"."分隔的字段路径，沿途自动解引用指针
+func resolveFieldPath(t reflect.Type, path string) (*fieldPath, error) {
+	key := fieldPathKey{typ: t, path: path}
+	if cached, ok := fieldPathCache.Load(key); ok {
+		return cached.(*fieldPath), nil
+	}
+
+	resolved := &fieldPath{path: path}
+	current := t
+	for _, segment := range strings.Split(path, ".") {
+		for current.Kind() == reflect.Pointer {
+			current = current.Elem()
+		}
+		if current.Kind() != reflect.Struct {
+			return nil, &FieldError{Type: t.String(), Field: path, Err: ErrUnknownField,
+				Detail: fmt.Sprintf("cannot access %q on non-struct type %s", segment, current)}
+		}
+		field, ok := structFieldsOf(current)[segment]
+		if !ok {
+			return nil, &FieldError{Type: t.String(), Field: path, Err: ErrUnknownField,
+				Detail: fmt.Sprintf("%s has no field or json tag %q", current, segment)}
+		}
+		resolved.steps = append(resolved.steps, field.index)
+		current = field.typ
+	}
+	resolved.typ = current
+
+	actual, _ := fieldPathCache.LoadOrStore(key, resolved)
+	return actual.(*fieldPath), nil
+}
+
+// resolveFieldPathFor 在类型T上解析字段路径
+func resolveFieldPathFor[T any](path string) (*fieldPath, error) {
+	return resolveFieldPath(reflect.TypeFor[T](), path)
+}
+
+// get 获取item上字段的值，路径上遇到nil指针时返回false
+func (p *fieldPath) get(item reflect.Value) (reflect.Value, bool) {
+	v := item
+	for _, step := range p.steps {
+		for _, i := range step {
+			for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+				if v.IsNil() {
+					return reflect.Value{}, false
+				}
+				v = v.Elem()
+			}
+			v = v.Field(i)
+		}
+	}
+	return v, true
+}
+
+// indirect 解引用指针和接口，遇到nil时返回false
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	return v, v.IsValid()
+}
+
+// indirectType 解引用指针类型
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
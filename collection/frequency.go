@@ -0,0 +1,161 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+// ErrInvalidEdges 直方图的分箱边界不合法
+var ErrInvalidEdges = errors.New("collection: invalid histogram edges")
+
+// CountBy 按键统计元素出现的次数，结果按键首次出现的顺序排列
+func CountBy[T any, K comparable](c *Collection[T], fn func(T) K) *MapCollection[K, int] {
+	counts := NewMap[K, int]()
+	for _, item := range c.items {
+		key := fn(item)
+		count, _ := counts.Get(key)
+		counts.Put(key, count+1)
+	}
+	return counts
+}
+
+// Frequencies 统计每个值出现的次数，结果按值首次出现的顺序排列
+func Frequencies[T comparable](c *Collection[T]) *MapCollection[T, int] {
+	return CountBy(c, func(item T) T { return item })
+}
+
+// MostCommonBy 返回出现次数最多的n个键，按次数降序排列，次数相同时按首次出现的顺序排列
+// n小于等于0时返回所有键
+func MostCommonBy[T any, K comparable](c *Collection[T], fn func(T) K, n int) *MapCollection[K, int] {
+	counts := CountBy(c, fn)
+	keys := slices.Clone(counts.keys)
+	slices.SortStableFunc(keys, func(a, b K) int {
+		return counts.values[b] - counts.values[a]
+	})
+	if n > 0 && n < len(keys) {
+		keys = keys[:n]
+	}
+	result := NewMap[K, int]()
+	for _, key := range keys {
+		result.Put(key, counts.values[key])
+	}
+	return result
+}
+
+// MostCommon 返回出现次数最多的n个值，按次数降序排列
+func MostCommon[T comparable](c *Collection[T], n int) *MapCollection[T, int] {
+	return MostCommonBy(c, func(item T) T { return item }, n)
+}
+
+// Bin 直方图的一个分箱，区间为[Lower, Upper)，最后一个分箱包含Upper
+type Bin struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int     `json:"count"`
+}
+
+// Binning 直方图的分箱方式，由 EqualWidthBins、ExplicitBins 或 QuantileBins 创建
+type Binning struct {
+	edges func(sorted []float64) ([]float64, error)
+}
+
+// EqualWidthBins 在最小值和最大值之间划分n个等宽分箱
+func EqualWidthBins(n int) Binning {
+	return Binning{edges: func(sorted []float64) ([]float64, error) {
+		if n <= 0 {
+			return nil, fmt.Errorf("%w: bin count must be positive, got %d", ErrInvalidSize, n)
+		}
+		if len(sorted) == 0 {
+			return nil, nil
+		}
+		lower, upper := sorted[0], sorted[len(sorted)-1]
+		if lower == upper {
+			return []float64{lower, upper}, nil
+		}
+		edges := make([]float64, n+1)
+		width := (upper - lower) / float64(n)
+		for i := range edges {
+			edges[i] = lower + width*float64(i)
+		}
+		edges[n] = upper
+		return edges, nil
+	}}
+}
+
+// ExplicitBins 使用给定的严格递增的边界划分分箱，超出边界的值不计入任何分箱
+func ExplicitBins(edges ...float64) Binning {
+	return Binning{edges: func([]float64) ([]float64, error) {
+		if len(edges) < 2 {
+			return nil, fmt.Errorf("%w: need at least 2 edges, got %d", ErrInvalidEdges, len(edges))
+		}
+		for i := 1; i < len(edges); i++ {
+			if !(edges[i] > edges[i-1]) {
+				return nil, fmt.Errorf("%w: edges must be strictly increasing at index %d", ErrInvalidEdges, i)
+			}
+		}
+		return edges, nil
+	}}
+}
+
+// QuantileBins 按分位点划分n个分箱，使每个分箱的元素数量大致相同，重复的边界会被合并
+func QuantileBins(n int) Binning {
+	return Binning{edges: func(sorted []float64) ([]float64, error) {
+		if n <= 0 {
+			return nil, fmt.Errorf("%w: bin count must be positive, got %d", ErrInvalidSize, n)
+		}
+		if len(sorted) == 0 {
+			return nil, nil
+		}
+		edges := make([]float64, 0, n+1)
+		for i := 0; i <= n; i++ {
+			edges = append(edges, percentileOfSorted(sorted, float64(i)*100/float64(n), PercentileLinear))
+		}
+		edges = slices.Compact(edges)
+		if len(edges) == 1 {
+			edges = append(edges, edges[0])
+		}
+		return edges, nil
+	}}
+}
+
+// Histogram 按给定的分箱方式统计数值分布，NaN 值会被忽略
+func Histogram[T any](c *Collection[T], fn func(T) float64, binning Binning) (*Collection[Bin], error) {
+	if binning.edges == nil {
+		return nil, fmt.Errorf("%w: zero Binning, use EqualWidthBins, ExplicitBins or QuantileBins", ErrInvalidEdges)
+	}
+	values := make([]float64, 0, len(c.items))
+	for _, item := range c.items {
+		if value := fn(item); !math.IsNaN(value) {
+			values = append(values, value)
+		}
+	}
+	slices.Sort(values)
+
+	edges, err := binning.edges(values)
+	if err != nil {
+		return nil, err
+	}
+	if len(edges) < 2 {
+		return &Collection[Bin]{items: []Bin{}}, nil
+	}
+
+	bins := make([]Bin, len(edges)-1)
+	for i := range bins {
+		bins[i] = Bin{Lower: edges[i], Upper: edges[i+1]}
+	}
+	last := len(bins) - 1
+	for _, value := range values {
+		if value < edges[0] || value > edges[len(edges)-1] {
+			continue
+		}
+		index := sort.SearchFloat64s(edges, value)
+		if index < len(edges) && edges[index] == value {
+			index++
+		}
+		bins[min(index-1, last)].Count++
+	}
+	return &Collection[Bin]{items: bins}, nil
+}
@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCountBy(t *testing.T) {
+	c := New("apple", "banana", "avocado", "cherry", "blueberry", "apricot")
+	counts := CountBy(c, func(s string) byte { return s[0] })
+	if !slices.Equal(counts.Keys().All(), []byte("abc")) || !slices.Equal(counts.Values().All(), []int{3, 2, 1}) {
+		t.Errorf("Unexpected counts %v", counts)
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	freq := Frequencies(New("b", "a", "b", "c", "b", "a"))
+	jsonStr, err := freq.ToJSON()
+	if err != nil || jsonStr != `{"b":3,"a":2,"c":1}` {
+		t.Errorf("Unexpected frequencies JSON %s %v", jsonStr, err)
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	c := New(1, 2, 3, 3, 2, 3, 4, 4)
+	top := MostCommon(c, 2)
+	if !slices.Equal(top.Keys().All(), []int{3, 2}) || !slices.Equal(top.Values().All(), []int{3, 2}) {
+		t.Errorf("Expected 3 and 2 as most common, got %v", top)
+	}
+
+	all := MostCommon(c, 0)
+	if !slices.Equal(all.Keys().All(), []int{3, 2, 4, 1}) {
+		t.Errorf("Expected ties in first-appearance order, got %v", all.Keys().All())
+	}
+
+	byLength := MostCommonBy(New("aa", "b", "cc", "dd"), func(s string) int { return len(s) }, 1)
+	if count, _ := byLength.Get(2); byLength.Count() != 1 || count != 3 {
+		t.Errorf("Expected length 2 with count 3, got %v", byLength)
+	}
+}
+
+func TestHistogramEqualWidth(t *testing.T) {
+	c := New(0.0, 1, 2, 5, 9, 10)
+	bins, err := Histogram(c, identity, EqualWidthBins(2))
+	if err != nil {
+		t.Fatalf("Histogram failed: %v", err)
+	}
+	expected := []Bin{{0, 5, 3}, {5, 10, 3}}
+	if !slices.Equal(bins.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, bins.All())
+	}
+
+	jsonStr, _ := bins.ToJSON()
+	if jsonStr != `[{"lower":0,"upper":5,"count":3},{"lower":5,"upper":10,"count":3}]` {
+		t.Errorf("Unexpected histogram JSON %s", jsonStr)
+	}
+}
+
+func TestHistogramExplicitEdges(t *testing.T) {
+	c := New(-1.0, 0, 5, 10, 15, 20, 25, math.NaN())
+	bins, err := Histogram(c, identity, ExplicitBins(0, 10, 20))
+	if err != nil {
+		t.Fatalf("Histogram failed: %v", err)
+	}
+	counts := Map(bins, func(b Bin) int { return b.Count })
+	if !slices.Equal(counts.All(), []int{2, 3}) {
+		t.Errorf("Expected counts [2 3], got %v", counts.All())
+	}
+
+	if _, err := Histogram(c, identity, ExplicitBins(0, 0, 1)); !errors.Is(err, ErrInvalidEdges) {
+		t.Errorf("Expected ErrInvalidEdges, got %v", err)
+	}
+	if _, err := Histogram(c, identity, ExplicitBins(1)); !errors.Is(err, ErrInvalidEdges) {
+		t.Errorf("Expected ErrInvalidEdges for single edge, got %v", err)
+	}
+}
+
+func TestHistogramQuantile(t *testing.T) {
+	c := New(1.0, 2, 3, 4, 5, 6, 7, 8, 100)
+	bins, err := Histogram(c, identity, QuantileBins(3))
+	if err != nil {
+		t.Fatalf("Histogram failed: %v", err)
+	}
+	counts := Map(bins, func(b Bin) int { return b.Count })
+	if !slices.Equal(counts.All(), []int{3, 3, 3}) {
+		t.Errorf("Expected balanced counts [3 3 3], got %v", counts.All())
+	}
+}
+
+func TestHistogramEdgeCases(t *testing.T) {
+	bins, err := Histogram(New[float64](), identity, EqualWidthBins(3))
+	if err != nil || !bins.IsEmpty() {
+		t.Errorf("Expected empty histogram, got %v %v", bins, err)
+	}
+
+	bins, _ = Histogram(New(7.0, 7, 7), identity, EqualWidthBins(3))
+	if !slices.Equal(bins.All(), []Bin{{7, 7, 3}}) {
+		t.Errorf("Expected single bin, got %v", bins.All())
+	}
+
+	if _, err := Histogram(New(1.0), identity, QuantileBins(0)); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize, got %v", err)
+	}
+}
@@ -0,0 +1,354 @@
+package collection
+
+import "iter"
+
+const (
+	immutableBits  = 5
+	immutableWidth = 1 << immutableBits
+	immutableMask  = immutableWidth - 1
+)
+
+// immutableNode 持久化向量的树节点，内部节点使用children，叶子节点使用leaf
+type immutableNode[T any] struct {
+	children []*immutableNode[T]
+	leaf     []T
+}
+
+// ImmutableCollection 基于持久化向量（32叉树 + 尾部缓冲）的不可变集合
+// 所有修改操作都返回新版本，新旧版本共享未修改的节点，可以安全地在多个协程之间传递
+type ImmutableCollection[T any] struct {
+	count int
+	shift uint
+	root  *immutableNode[T]
+	tail  []T
+}
+
+// NewImmutable 创建一个新的不可变集合
+func NewImmutable[T any](items ...T) *ImmutableCollection[T] {
+	return immutableFromSlice(items)
+}
+
+// Immutable 将集合转换为不可变集合，之后对原集合的修改不会影响不可变集合
+func (c *Collection[T]) Immutable() *ImmutableCollection[T] {
+	return immutableFromSlice(c.items)
+}
+
+// immutableFromSlice 自底向上构建持久化向量，会复制items
+func immutableFromSlice[T any](items []T) *ImmutableCollection[T] {
+	tailOffset := 0
+	if len(items) > 0 {
+		tailOffset = ((len(items) - 1) >> immutableBits) << immutableBits
+	}
+
+	nodes := make([]*immutableNode[T], 0, tailOffset/immutableWidth)
+	for i := 0; i < tailOffset; i += immutableWidth {
+		leaf := make([]T, immutableWidth)
+		copy(leaf, items[i:i+immutableWidth])
+		nodes = append(nodes, &immutableNode[T]{leaf: leaf})
+	}
+
+	shift := uint(immutableBits)
+	for len(nodes) > immutableWidth {
+		parents := make([]*immutableNode[T], 0, (len(nodes)+immutableMask)/immutableWidth)
+		for i := 0; i < len(nodes); i += immutableWidth {
+			end := min(i+immutableWidth, len(nodes))
+			parents = append(parents, &immutableNode[T]{children: nodes[i:end:end]})
+		}
+		nodes = parents
+		shift += immutableBits
+	}
+
+	tail := make([]T, len(items)-tailOffset)
+	copy(tail, items[tailOffset:])
+	return &ImmutableCollection[T]{
+		count: len(items),
+		shift: shift,
+		root:  &immutableNode[T]{children: nodes},
+		tail:  tail,
+	}
+}
+
+// tailOffset 返回尾部缓冲中第一个元素的索引
+func (v *ImmutableCollection[T]) tailOffset() int {
+	if v.count < immutableWidth {
+		return 0
+	}
+	return ((v.count - 1) >> immutableBits) << immutableBits
+}
+
+// leafFor 返回包含索引i的叶子数组
+func (v *ImmutableCollection[T]) leafFor(i int) []T {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= immutableBits {
+		node = node.children[(i>>level)&immutableMask]
+	}
+	return node.leaf
+}
+
+// Count 返回集合中的项数
+func (v *ImmutableCollection[T]) Count() int {
+	return v.count
+}
+
+// IsEmpty 检查集合是否为空
+func (v *ImmutableCollection[T]) IsEmpty() bool {
+	return v.count == 0
+}
+
+// Get 根据索引获取元素
+func (v *ImmutableCollection[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= v.count {
+		var zero T
+		return zero, false
+	}
+	return v.leafFor(index)[index&immutableMask], true
+}
+
+// First 获取集合的第一个元素
+func (v *ImmutableCollection[T]) First() (T, bool) {
+	return v.Get(0)
+}
+
+// Last 获取集合的最后一个元素
+func (v *ImmutableCollection[T]) Last() (T, bool) {
+	return v.Get(v.count - 1)
+}
+
+// Values 返回按顺序遍历集合元素的迭代器
+func (v *ImmutableCollection[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < v.count; i += immutableWidth {
+			for _, item := range v.leafFor(i) {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All 返回包含所有元素的新切片
+func (v *ImmutableCollection[T]) All() []T {
+	items := make([]T, 0, v.count)
+	for item := range v.Values() {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Collect 转换为普通集合
+func (v *ImmutableCollection[T]) Collect() *Collection[T] {
+	return &Collection[T]{items: v.All()}
+}
+
+// Push 返回在末尾添加了元素的新版本
+func (v *ImmutableCollection[T]) Push(items ...T) *ImmutableCollection[T] {
+	result := v
+	for _, item := range items {
+		result = result.push(item)
+	}
+	return result
+}
+
+// push 添加单个元素，只复制从根到新叶子路径上的节点
+func (v *ImmutableCollection[T]) push(item T) *ImmutableCollection[T] {
+	if v.count-v.tailOffset() < immutableWidth {
+		tail := make([]T, len(v.tail)+1)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = item
+		return &ImmutableCollection[T]{count: v.count + 1, shift: v.shift, root: v.root, tail: tail}
+	}
+
+	tailNode := &immutableNode[T]{leaf: v.tail}
+	shift := v.shift
+	var root *immutableNode[T]
+	if (v.count >> immutableBits) > (1 << v.shift) {
+		root = &immutableNode[T]{children: []*immutableNode[T]{v.root, newImmutablePath(v.shift, tailNode)}}
+		shift += immutableBits
+	} else {
+		root = v.pushTail(v.shift, v.root, tailNode)
+	}
+	return &ImmutableCollection[T]{count: v.count + 1, shift: shift, root: root, tail: []T{item}}
+}
+
+// pushTail 将已满的尾部缓冲作为叶子挂到树上
+func (v *ImmutableCollection[T]) pushTail(level uint, parent, tailNode *immutableNode[T]) *immutableNode[T] {
+	index := ((v.count - 1) >> level) & immutableMask
+	node := parent.clone()
+	var child *immutableNode[T]
+	if level == immutableBits {
+		child = tailNode
+	} else if index < len(parent.children) {
+		child = v.pushTail(level-immutableBits, parent.children[index], tailNode)
+	} else {
+		child = newImmutablePath(level-immutableBits, tailNode)
+	}
+	if index < len(node.children) {
+		node.children[index] = child
+	} else {
+		node.children = append(node.children, child)
+	}
+	return node
+}
+
+// Pop 返回移除了最后一个元素的新版本以及被移除的元素
+func (v *ImmutableCollection[T]) Pop() (*ImmutableCollection[T], T, bool) {
+	if v.count == 0 {
+		var zero T
+		return v, zero, false
+	}
+	last, _ := v.Last()
+	if v.count == 1 {
+		return NewImmutable[T](), last, true
+	}
+	if v.count-v.tailOffset() > 1 {
+		tail := v.tail[: len(v.tail)-1 : len(v.tail)-1]
+		return &ImmutableCollection[T]{count: v.count - 1, shift: v.shift, root: v.root, tail: tail}, last, true
+	}
+
+	tail := v.leafFor(v.count - 2)
+	shift := v.shift
+	root := v.popTail(v.shift, v.root)
+	if root == nil {
+		root = &immutableNode[T]{}
+	}
+	if shift > immutableBits && len(root.children) == 1 {
+		root = root.children[0]
+		shift -= immutableBits
+	}
+	return &ImmutableCollection[T]{count: v.count - 1, shift: shift, root: root, tail: tail}, last, true
+}
+
+// popTail 从树上摘下最后一个叶子，节点变空时返回nil
+func (v *ImmutableCollection[T]) popTail(level uint, node *immutableNode[T]) *immutableNode[T] {
+	index := ((v.count - 2) >> level) & immutableMask
+	if level > immutableBits {
+		child := v.popTail(level-immutableBits, node.children[index])
+		if child == nil && index == 0 {
+			return nil
+		}
+		result := node.clone()
+		if child == nil {
+			result.children = result.children[:index]
+		} else {
+			result.children[index] = child
+		}
+		return result
+	}
+	if index == 0 {
+		return nil
+	}
+	result := node.clone()
+	result.children = result.children[:index]
+	return result
+}
+
+// Set 返回替换了指定索引元素的新版本，索引越界时返回原版本和false
+func (v *ImmutableCollection[T]) Set(index int, item T) (*ImmutableCollection[T], bool) {
+	if index < 0 || index >= v.count {
+		return v, false
+	}
+	if index >= v.tailOffset() {
+		tail := make([]T, len(v.tail))
+		copy(tail, v.tail)
+		tail[index&immutableMask] = item
+		return &ImmutableCollection[T]{count: v.count, shift: v.shift, root: v.root, tail: tail}, true
+	}
+	root := setImmutable(v.shift, v.root, index, item)
+	return &ImmutableCollection[T]{count: v.count, shift: v.shift, root: root, tail: v.tail}, true
+}
+
+// setImmutable 复制从根到目标叶子路径上的节点并替换元素
+func setImmutable[T any](level uint, node *immutableNode[T], index int, item T) *immutableNode[T] {
+	result := node.clone()
+	if level == 0 {
+		result.leaf[index&immutableMask] = item
+		return result
+	}
+	child := (index >> level) & immutableMask
+	result.children[child] = setImmutable(level-immutableBits, node.children[child], index, item)
+	return result
+}
+
+// Prepend 返回在开头添加了元素的新版本，需要重建整个向量
+func (v *ImmutableCollection[T]) Prepend(items ...T) *ImmutableCollection[T] {
+	merged := make([]T, 0, len(items)+v.count)
+	merged = append(merged, items...)
+	for item := range v.Values() {
+		merged = append(merged, item)
+	}
+	return immutableFromSlice(merged)
+}
+
+// Filter 返回只包含满足条件元素的新版本
+func (v *ImmutableCollection[T]) Filter(fn func(T) bool) *ImmutableCollection[T] {
+	filtered := make([]T, 0)
+	for item := range v.Values() {
+		if fn(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return immutableFromSlice(filtered)
+}
+
+// Slice 返回指定范围元素的新版本
+func (v *ImmutableCollection[T]) Slice(start, end int) *ImmutableCollection[T] {
+	start = max(start, 0)
+	end = min(end, v.count)
+	start = min(start, end)
+	if start == 0 && end == v.count {
+		return v
+	}
+	items := make([]T, 0, end-start)
+	for i := start; i < end; i++ {
+		item, _ := v.Get(i)
+		items = append(items, item)
+	}
+	return immutableFromSlice(items)
+}
+
+// Take 返回前n个元素的新版本，n为负数时取后n个元素
+func (v *ImmutableCollection[T]) Take(n int) *ImmutableCollection[T] {
+	if n < 0 {
+		return v.Slice(v.count+n, v.count)
+	}
+	return v.Slice(0, n)
+}
+
+// Skip 返回跳过前n个元素的新版本
+func (v *ImmutableCollection[T]) Skip(n int) *ImmutableCollection[T] {
+	return v.Slice(n, v.count)
+}
+
+// Each 遍历集合中的每个元素
+func (v *ImmutableCollection[T]) Each(fn func(T)) *ImmutableCollection[T] {
+	for item := range v.Values() {
+		fn(item)
+	}
+	return v
+}
+
+// clone 浅复制节点
+func (n *immutableNode[T]) clone() *immutableNode[T] {
+	result := &immutableNode[T]{}
+	if n.children != nil {
+		result.children = make([]*immutableNode[T], len(n.children), immutableWidth)
+		copy(result.children, n.children)
+	}
+	if n.leaf != nil {
+		result.leaf = make([]T, len(n.leaf))
+		copy(result.leaf, n.leaf)
+	}
+	return result
+}
+
+// newImmutablePath 创建从指定层级到叶子节点的路径
+func newImmutablePath[T any](level uint, node *immutableNode[T]) *immutableNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &immutableNode[T]{children: []*immutableNode[T]{newImmutablePath(level-immutableBits, node)}}
+}
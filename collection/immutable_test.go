@@ -0,0 +1,148 @@
+package collection
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestImmutablePushGetAgainstSlice(t *testing.T) {
+	v := NewImmutable[int]()
+	expected := make([]int, 0)
+	for i := range 40000 {
+		v = v.Push(i)
+		expected = append(expected, i)
+	}
+	if v.Count() != len(expected) {
+		t.Fatalf("Expected count %d, got %d", len(expected), v.Count())
+	}
+	for _, i := range []int{0, 31, 32, 1023, 1024, 1055, 1056, 32767, 32800, 39999} {
+		if item, ok := v.Get(i); !ok || item != i {
+			t.Errorf("Expected %d at index %d, got %d", i, i, item)
+		}
+	}
+	if !slices.Equal(v.All(), expected) {
+		t.Error("Expected All to match pushed items")
+	}
+	if !slices.Equal(NewImmutable(expected...).All(), expected) {
+		t.Error("Expected bulk-built vector to match items")
+	}
+}
+
+func TestImmutablePopAgainstSlice(t *testing.T) {
+	items := make([]int, 2000)
+	for i := range items {
+		items[i] = i
+	}
+	v := NewImmutable(items...)
+	for len(items) > 0 {
+		next, last, ok := v.Pop()
+		if !ok || last != items[len(items)-1] {
+			t.Fatalf("Expected to pop %d, got %d", items[len(items)-1], last)
+		}
+		items = items[:len(items)-1]
+		if next.Count() != len(items) {
+			t.Fatalf("Expected count %d, got %d", len(items), next.Count())
+		}
+		if len(items)%97 == 0 && !slices.Equal(next.All(), items) {
+			t.Fatalf("Mismatch after popping to %d items", len(items))
+		}
+		v = next
+	}
+	if _, _, ok := v.Pop(); ok {
+		t.Error("Expected Pop on empty collection to fail")
+	}
+}
+
+func TestImmutableRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	v := NewImmutable[int]()
+	expected := make([]int, 0)
+	for range 20000 {
+		switch op := r.Intn(10); {
+		case op < 6:
+			n := r.Int()
+			v = v.Push(n)
+			expected = append(expected, n)
+		case op < 8 && len(expected) > 0:
+			v, _, _ = v.Pop()
+			expected = expected[:len(expected)-1]
+		case len(expected) > 0:
+			i, n := r.Intn(len(expected)), r.Int()
+			v, _ = v.Set(i, n)
+			expected[i] = n
+		}
+	}
+	if !slices.Equal(v.All(), expected) {
+		t.Error("Expected random operations to match slice model")
+	}
+}
+
+func TestImmutableStructuralSharing(t *testing.T) {
+	base := NewImmutable(1, 2, 3)
+	pushed := base.Push(4)
+	set, _ := base.Set(0, 100)
+	popped, _, _ := base.Pop()
+
+	if !slices.Equal(base.All(), []int{1, 2, 3}) {
+		t.Errorf("Expected base unchanged, got %v", base.All())
+	}
+	if !slices.Equal(pushed.All(), []int{1, 2, 3, 4}) || !slices.Equal(set.All(), []int{100, 2, 3}) || !slices.Equal(popped.All(), []int{1, 2}) {
+		t.Errorf("Unexpected versions %v %v %v", pushed.All(), set.All(), popped.All())
+	}
+
+	branch := popped.Push(9)
+	if !slices.Equal(base.All(), []int{1, 2, 3}) || !slices.Equal(branch.All(), []int{1, 2, 9}) {
+		t.Errorf("Expected independent branches, got %v %v", base.All(), branch.All())
+	}
+}
+
+func TestImmutableDerivedVersions(t *testing.T) {
+	c := New(1, 2, 3, 4, 5)
+	v := c.Immutable()
+	c.Push(6)
+	c.items[0] = 100
+
+	if !slices.Equal(v.All(), []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Expected snapshot unaffected by source mutation, got %v", v.All())
+	}
+	if !slices.Equal(v.Take(2).All(), []int{1, 2}) || !slices.Equal(v.Take(-2).All(), []int{4, 5}) || !slices.Equal(v.Skip(3).All(), []int{4, 5}) {
+		t.Error("Take/Skip failed")
+	}
+	if !slices.Equal(v.Slice(1, 3).Push(9).All(), []int{2, 3, 9}) || !slices.Equal(v.All(), []int{1, 2, 3, 4, 5}) {
+		t.Error("Expected Push on derived version not to affect original")
+	}
+	if !slices.Equal(v.Filter(func(n int) bool { return n%2 == 1 }).All(), []int{1, 3, 5}) {
+		t.Error("Filter failed")
+	}
+	if !slices.Equal(v.Prepend(-1, 0).All(), []int{-1, 0, 1, 2, 3, 4, 5}) {
+		t.Error("Prepend failed")
+	}
+	if !slices.Equal(v.Collect().All(), []int{1, 2, 3, 4, 5}) {
+		t.Error("Collect failed")
+	}
+}
+
+func TestImmutableConcurrentReaders(t *testing.T) {
+	v := NewImmutable[int]()
+	for i := range 5000 {
+		v = v.Push(i)
+	}
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local := v
+			for i := range 500 {
+				local, _ = local.Set(i, w)
+				local = local.Push(i)
+			}
+			if item, _ := v.Get(0); item != 0 {
+				t.Errorf("Expected shared snapshot unchanged, got %d", item)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,75 @@
+package collection
+
+import "iter"
+
+// Values 返回按顺序遍历集合元素的迭代器
+func (c *Collection[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range c.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate 返回按顺序遍历索引和元素的迭代器
+func (c *Collection[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, item := range c.items {
+			if !yield(i, item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward 返回从末尾向开头遍历索引和元素的迭代器
+func (c *Collection[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(c.items) - 1; i >= 0; i-- {
+			if !yield(i, c.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq 从迭代器创建集合
+func FromSeq[T any](seq iter.Seq[T]) *Collection[T] {
+	items := make([]T, 0)
+	for item := range seq {
+		items = append(items, item)
+	}
+	return &Collection[T]{items: items}
+}
+
+// FromSeq2 从键值迭代器创建集合，只保留值（例如 slices.All、maps.All 的值部分）
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) *Collection[V] {
+	items := make([]V, 0)
+	for _, item := range seq {
+		items = append(items, item)
+	}
+	return &Collection[V]{items: items}
+}
+
+// GroupBySeq 根据给定的键对集合进行分组，并按键首次出现的顺序遍历分组
+func GroupBySeq[T any, K comparable](c *Collection[T], fn func(T) K) iter.Seq2[K, *Collection[T]] {
+	return func(yield func(K, *Collection[T]) bool) {
+		groups := make(map[K]*Collection[T])
+		keys := make([]K, 0)
+		for _, item := range c.items {
+			key := fn(item)
+			if _, ok := groups[key]; !ok {
+				groups[key] = &Collection[T]{items: make([]T, 0)}
+				keys = append(keys, key)
+			}
+			groups[key].items = append(groups[key].items, item)
+		}
+		for _, key := range keys {
+			if !yield(key, groups[key]) {
+				return
+			}
+		}
+	}
+}
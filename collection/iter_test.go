@@ -0,0 +1,80 @@
+package collection
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	c := New(1, 2, 3)
+	sum := 0
+	for n := range c.Values() {
+		sum += n
+	}
+	if sum != 6 {
+		t.Errorf("Expected sum 6, got %d", sum)
+	}
+
+	collected := slices.Collect(c.Values())
+	if !slices.Equal(collected, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", collected)
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	c := New("a", "b", "c")
+	for i, item := range c.Enumerate() {
+		if expected, _ := c.Get(i); expected != item {
+			t.Errorf("Expected %s at index %d, got %s", expected, i, item)
+		}
+		if i == 1 {
+			break
+		}
+	}
+}
+
+func TestBackward(t *testing.T) {
+	c := New(1, 2, 3)
+	indexes := make([]int, 0)
+	items := make([]int, 0)
+	for i, item := range c.Backward() {
+		indexes = append(indexes, i)
+		items = append(items, item)
+	}
+	if !slices.Equal(indexes, []int{2, 1, 0}) || !slices.Equal(items, []int{3, 2, 1}) {
+		t.Errorf("Backward failed, got indexes %v items %v", indexes, items)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	c := FromSeq(slices.Values([]int{3, 1, 2}))
+	if !slices.Equal(c.All(), []int{3, 1, 2}) {
+		t.Errorf("Expected [3 1 2], got %v", c.All())
+	}
+
+	keys := FromSeq(maps.Keys(map[string]int{"a": 1}))
+	if keys.Count() != 1 {
+		t.Errorf("Expected count 1, got %d", keys.Count())
+	}
+}
+
+func TestFromSeq2(t *testing.T) {
+	c := FromSeq2(New(1, 2, 3).Backward())
+	if !slices.Equal(c.All(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1], got %v", c.All())
+	}
+}
+
+func TestGroupBySeq(t *testing.T) {
+	c := New("apple", "banana", "avocado", "cherry", "blueberry")
+	keys := make([]byte, 0)
+	counts := make([]int, 0)
+	for key, group := range GroupBySeq(c, func(s string) byte { return s[0] }) {
+		keys = append(keys, key)
+		counts = append(counts, group.Count())
+	}
+	if string(keys) != "abc" || !slices.Equal(counts, []int{2, 2, 1}) {
+		t.Errorf("Expected stable groups abc with [2 2 1], got %s %v", keys, counts)
+	}
+}
@@ -0,0 +1,94 @@
+package collection
+
+// joinIndex 按键建立元素索引，同一键的元素保持原有顺序
+func joinIndex[T any, K comparable](c *Collection[T], keyFn func(T) K) map[K][]int {
+	index := make(map[K][]int)
+	for i, item := range c.items {
+		key := keyFn(item)
+		index[key] = append(index[key], i)
+	}
+	return index
+}
+
+// InnerJoin 基于哈希的内连接，只返回两侧键相同的组合
+// 结果按左侧顺序排列，同一左侧元素的多个匹配按右侧顺序排列
+func InnerJoin[L, R any, K comparable, O any](left *Collection[L], right *Collection[R], leftKey func(L) K, rightKey func(R) K, combine func(L, R) O) *Collection[O] {
+	index := joinIndex(right, rightKey)
+	joined := make([]O, 0)
+	for _, l := range left.items {
+		for _, i := range index[leftKey(l)] {
+			joined = append(joined, combine(l, right.items[i]))
+		}
+	}
+	return &Collection[O]{items: joined}
+}
+
+// LeftJoin 基于哈希的左连接，保留所有左侧元素，没有匹配时传给combine的右侧元素为nil
+func LeftJoin[L, R any, K comparable, O any](left *Collection[L], right *Collection[R], leftKey func(L) K, rightKey func(R) K, combine func(L, *R) O) *Collection[O] {
+	index := joinIndex(right, rightKey)
+	joined := make([]O, 0)
+	for _, l := range left.items {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			joined = append(joined, combine(l, nil))
+			continue
+		}
+		for _, i := range matches {
+			r := right.items[i]
+			joined = append(joined, combine(l, &r))
+		}
+	}
+	return &Collection[O]{items: joined}
+}
+
+// RightJoin 基于哈希的右连接，保留所有右侧元素，没有匹配时传给combine的左侧元素为nil
+// 结果按右侧顺序排列
+func RightJoin[L, R any, K comparable, O any](left *Collection[L], right *Collection[R], leftKey func(L) K, rightKey func(R) K, combine func(*L, R) O) *Collection[O] {
+	return LeftJoin(right, left, rightKey, leftKey, func(r R, l *L) O {
+		return combine(l, r)
+	})
+}
+
+// FullOuterJoin 基于哈希的全外连接，保留两侧所有元素，没有匹配的一侧传给combine的值为nil
+// 结果先按左连接的顺序排列，再追加没有匹配的右侧元素
+func FullOuterJoin[L, R any, K comparable, O any](left *Collection[L], right *Collection[R], leftKey func(L) K, rightKey func(R) K, combine func(*L, *R) O) *Collection[O] {
+	index := joinIndex(right, rightKey)
+	matched := make([]bool, len(right.items))
+	joined := make([]O, 0)
+	for _, l := range left.items {
+		matches := index[leftKey(l)]
+		if len(matches) == 0 {
+			joined = append(joined, combine(&l, nil))
+			continue
+		}
+		for _, i := range matches {
+			matched[i] = true
+			r := right.items[i]
+			joined = append(joined, combine(&l, &r))
+		}
+	}
+	for i, r := range right.items {
+		if !matched[i] {
+			joined = append(joined, combine(nil, &r))
+		}
+	}
+	return &Collection[O]{items: joined}
+}
+
+// SemiJoin 返回在右侧存在匹配键的左侧元素，每个左侧元素最多出现一次
+func SemiJoin[L, R any, K comparable](left *Collection[L], right *Collection[R], leftKey func(L) K, rightKey func(R) K) *Collection[L] {
+	index := joinIndex(right, rightKey)
+	return left.Filter(func(l L) bool {
+		_, ok := index[leftKey(l)]
+		return ok
+	})
+}
+
+// AntiJoin 返回在右侧不存在匹配键的左侧元素
+func AntiJoin[L, R any, K comparable](left *Collection[L], right *Collection[R], leftKey func(L) K, rightKey func(R) K) *Collection[L] {
+	index := joinIndex(right, rightKey)
+	return left.Reject(func(l L) bool {
+		_, ok := index[leftKey(l)]
+		return ok
+	})
+}
@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestJoins(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	type Order struct {
+		ID     int
+		UserID int
+	}
+	users := New(User{1, "Alice"}, User{2, "Bob"}, User{3, "Carol"})
+	orders := New(Order{10, 1}, Order{11, 3}, Order{12, 1}, Order{13, 9})
+	userID := func(u User) int { return u.ID }
+	orderUser := func(o Order) int { return o.UserID }
+	label := func(u *User, o *Order) string {
+		name, id := "-", "-"
+		if u != nil {
+			name = u.Name
+		}
+		if o != nil {
+			id = fmt.Sprint(o.ID)
+		}
+		return name + ":" + id
+	}
+	userName := func(u User) string { return u.Name }
+
+	tests := []struct {
+		name     string
+		join     func() *Collection[string]
+		expected []string
+	}{
+		{"InnerJoin", func() *Collection[string] {
+			return InnerJoin(users, orders, userID, orderUser, func(u User, o Order) string { return label(&u, &o) })
+		}, []string{"Alice:10", "Alice:12", "Carol:11"}},
+		{"LeftJoin", func() *Collection[string] {
+			return LeftJoin(users, orders, userID, orderUser, func(u User, o *Order) string { return label(&u, o) })
+		}, []string{"Alice:10", "Alice:12", "Bob:-", "Carol:11"}},
+		{"RightJoin", func() *Collection[string] {
+			return RightJoin(users, orders, userID, orderUser, func(u *User, o Order) string { return label(u, &o) })
+		}, []string{"Alice:10", "Carol:11", "Alice:12", "-:13"}},
+		{"FullOuterJoin", func() *Collection[string] {
+			return FullOuterJoin(users, orders, userID, orderUser, label)
+		}, []string{"Alice:10", "Alice:12", "Bob:-", "Carol:11", "-:13"}},
+		{"SemiJoin", func() *Collection[string] {
+			return Map(SemiJoin(users, orders, userID, orderUser), userName)
+		}, []string{"Alice", "Carol"}},
+		{"AntiJoin", func() *Collection[string] {
+			return Map(AntiJoin(users, orders, userID, orderUser), userName)
+		}, []string{"Bob"}},
+	}
+	for _, tt := range tests {
+		if got := tt.join().All(); !slices.Equal(got, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
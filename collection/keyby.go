@@ -0,0 +1,101 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey 在 ErrorOnCollision 策略下出现重复的键
+var ErrDuplicateKey = errors.New("collection: duplicate key")
+
+// CollisionPolicy 转换为map时重复键的处理策略
+type CollisionPolicy int
+
+const (
+	// KeepFirst 保留第一个出现的值
+	KeepFirst CollisionPolicy = iota
+	// KeepLast 保留最后一个出现的值
+	KeepLast
+	// ErrorOnCollision 出现重复的键时返回 *DuplicateKeyError
+	ErrorOnCollision
+)
+
+// DuplicateKeyError 记录重复的键及其首次出现和再次出现的索引
+type DuplicateKeyError struct {
+	Key        any
+	FirstIndex int
+	Index      int
+}
+
+// Error 实现error接口
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("collection: duplicate key %v at index %d (first seen at index %d)", e.Key, e.Index, e.FirstIndex)
+}
+
+// Unwrap 使 errors.Is(err, ErrDuplicateKey) 成立
+func (e *DuplicateKeyError) Unwrap() error {
+	return ErrDuplicateKey
+}
+
+// ToMap 使用键函数和值函数将集合转换为map，重复的键按policy处理
+func ToMap[T any, K comparable, V any](c *Collection[T], keyFn func(T) K, valueFn func(T) V, policy CollisionPolicy) (map[K]V, error) {
+	result := make(map[K]V, len(c.items))
+	firstIndex := make(map[K]int, len(c.items))
+	for i, item := range c.items {
+		key := keyFn(item)
+		if first, exists := firstIndex[key]; exists {
+			switch policy {
+			case KeepFirst:
+				continue
+			case ErrorOnCollision:
+				return nil, &DuplicateKeyError{Key: key, FirstIndex: first, Index: i}
+			}
+		} else {
+			firstIndex[key] = i
+		}
+		result[key] = valueFn(item)
+	}
+	return result, nil
+}
+
+// ToMapMerge 使用键函数和值函数将集合转换为map，重复的键通过merge合并已有值和新值
+func ToMapMerge[T any, K comparable, V any](c *Collection[T], keyFn func(T) K, valueFn func(T) V, merge func(existing, incoming V) V) map[K]V {
+	result := make(map[K]V, len(c.items))
+	for _, item := range c.items {
+		key := keyFn(item)
+		value := valueFn(item)
+		if existing, exists := result[key]; exists {
+			value = merge(existing, value)
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// KeyBy 以键函数的返回值为键将集合转换为map，重复的键按policy处理
+func KeyBy[T any, K comparable](c *Collection[T], keyFn func(T) K, policy CollisionPolicy) (map[K]T, error) {
+	return ToMap(c, keyFn, func(item T) T { return item }, policy)
+}
+
+// KeyByMerge 以键函数的返回值为键将集合转换为map，重复的键通过merge合并
+func KeyByMerge[T any, K comparable](c *Collection[T], keyFn func(T) K, merge func(existing, incoming T) T) map[K]T {
+	return ToMapMerge(c, keyFn, func(item T) T { return item }, merge)
+}
+
+// IndexBy 返回键到元素索引的map，重复的键按policy处理
+func IndexBy[T any, K comparable](c *Collection[T], keyFn func(T) K, policy CollisionPolicy) (map[K]int, error) {
+	indexes := &Collection[int]{items: make([]int, len(c.items))}
+	for i := range indexes.items {
+		indexes.items[i] = i
+	}
+	return ToMap(indexes, func(i int) K { return keyFn(c.items[i]) }, func(i int) int { return i }, policy)
+}
+
+// ToSet 将集合转换为集合类型的map
+func ToSet[T comparable](c *Collection[T]) map[T]struct{} {
+	set := make(map[T]struct{}, len(c.items))
+	for _, item := range c.items {
+		set[item] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,94 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestKeyByPolicies(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	users := New(User{1, "Alice"}, User{2, "Bob"}, User{1, "Alicia"})
+
+	id := func(u User) int { return u.ID }
+
+	first, err := KeyBy(users, id, KeepFirst)
+	if err != nil || len(first) != 2 || first[1].Name != "Alice" {
+		t.Errorf("KeepFirst failed: %v %v", first, err)
+	}
+
+	last, err := KeyBy(users, id, KeepLast)
+	if err != nil || last[1].Name != "Alicia" {
+		t.Errorf("KeepLast failed: %v %v", last, err)
+	}
+
+	_, err = KeyBy(users, id, ErrorOnCollision)
+	var dupErr *DuplicateKeyError
+	if !errors.Is(err, ErrDuplicateKey) || !errors.As(err, &dupErr) {
+		t.Fatalf("Expected DuplicateKeyError, got %v", err)
+	}
+	if dupErr.Key != 1 || dupErr.FirstIndex != 0 || dupErr.Index != 2 {
+		t.Errorf("Unexpected error details %+v", dupErr)
+	}
+}
+
+func TestKeyByMerge(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	users := New(User{1, "Alice"}, User{2, "Bob"}, User{1, "Alicia"})
+	merged := KeyByMerge(users, func(u User) int { return u.ID }, func(existing, incoming User) User {
+		existing.Name += "/" + incoming.Name
+		return existing
+	})
+	if merged[1].Name != "Alice/Alicia" || merged[2].Name != "Bob" {
+		t.Errorf("Unexpected merge result %v", merged)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	users := New(User{1, "Alice"}, User{2, "Bob"}, User{1, "Alicia"})
+	names, err := ToMap(users, func(u User) int { return u.ID }, func(u User) string { return u.Name }, KeepLast)
+	if err != nil || names[1] != "Alicia" || names[2] != "Bob" {
+		t.Errorf("ToMap failed: %v %v", names, err)
+	}
+
+	counts := ToMapMerge(New("a", "b", "a"), func(s string) string { return s }, func(string) int { return 1 }, func(a, b int) int { return a + b })
+	if counts["a"] != 2 || counts["b"] != 1 {
+		t.Errorf("ToMapMerge failed: %v", counts)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	users := New(User{1, "Alice"}, User{2, "Bob"}, User{1, "Alicia"})
+	indexes, err := IndexBy(users, func(u User) string { return u.Name }, ErrorOnCollision)
+	if err != nil || indexes["Bob"] != 1 || indexes["Alicia"] != 2 {
+		t.Errorf("IndexBy failed: %v %v", indexes, err)
+	}
+
+	byID, _ := IndexBy(users, func(u User) int { return u.ID }, KeepFirst)
+	if byID[1] != 0 {
+		t.Errorf("Expected first index 0, got %d", byID[1])
+	}
+}
+
+func TestToSet(t *testing.T) {
+	set := ToSet(New(1, 2, 2, 3))
+	if len(set) != 3 {
+		t.Errorf("Expected 3 elements, got %d", len(set))
+	}
+	if _, ok := set[2]; !ok {
+		t.Error("Expected set to contain 2")
+	}
+}
@@ -0,0 +1,176 @@
+package collection
+
+import "iter"
+
+// LazyCollection 基于 iter.Seq 的惰性集合，所有中间操作都延迟到终结操作时才执行
+type LazyCollection[T any] struct {
+	seq iter.Seq[T]
+}
+
+// NewLazy 从迭代器创建惰性集合
+func NewLazy[T any](seq iter.Seq[T]) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: seq}
+}
+
+// Lazy 将集合转换为惰性集合
+func (c *Collection[T]) Lazy() *LazyCollection[T] {
+	return &LazyCollection[T]{seq: c.Values()}
+}
+
+// Collect 执行惰性集合并返回普通集合
+func (l *LazyCollection[T]) Collect() *Collection[T] {
+	return &Collection[T]{items: l.All()}
+}
+
+// Values 返回惰性集合底层的迭代器
+func (l *LazyCollection[T]) Values() iter.Seq[T] {
+	return l.seq
+}
+
+// Filter 根据给定的回调函数惰性过滤集合
+func (l *LazyCollection[T]) Filter(fn func(T) bool) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		for item := range l.seq {
+			if fn(item) && !yield(item) {
+				return
+			}
+		}
+	}}
+}
+
+// Reject 根据给定的回调函数惰性排除集合中的元素
+func (l *LazyCollection[T]) Reject(fn func(T) bool) *LazyCollection[T] {
+	return l.Filter(func(item T) bool {
+		return !fn(item)
+	})
+}
+
+// Skip 惰性跳过集合的前n个元素
+func (l *LazyCollection[T]) Skip(n int) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		skipped := 0
+		for item := range l.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}}
+}
+
+// Take 惰性获取集合的前n个元素，满足数量后立即停止读取源数据
+// 当n为负数时取后n个元素，此时需要完整遍历源数据
+func (l *LazyCollection[T]) Take(n int) *LazyCollection[T] {
+	if n < 0 {
+		return l.takeLast(-n)
+	}
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		taken := 0
+		for item := range l.seq {
+			if !yield(item) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}}
+}
+
+// takeLast 使用环形缓冲区保留最后n个元素
+func (l *LazyCollection[T]) takeLast(n int) *LazyCollection[T] {
+	return &LazyCollection[T]{seq: func(yield func(T) bool) {
+		if n == 0 {
+			return
+		}
+		ring := make([]T, 0, n)
+		start := 0
+		for item := range l.seq {
+			if len(ring) < n {
+				ring = append(ring, item)
+				continue
+			}
+			ring[start] = item
+			start = (start + 1) % n
+		}
+		for i := range ring {
+			if !yield(ring[(start+i)%len(ring)]) {
+				return
+			}
+		}
+	}}
+}
+
+// All 执行惰性集合并获取所有元素
+func (l *LazyCollection[T]) All() []T {
+	items := make([]T, 0)
+	for item := range l.seq {
+		items = append(items, item)
+	}
+	return items
+}
+
+// First 获取惰性集合的第一个元素，只读取一个源元素
+func (l *LazyCollection[T]) First() (T, bool) {
+	for item := range l.seq {
+		return item, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Count 执行惰性集合并返回元素数量
+func (l *LazyCollection[T]) Count() int {
+	count := 0
+	for range l.seq {
+		count++
+	}
+	return count
+}
+
+// Each 执行惰性集合并遍历每个元素
+func (l *LazyCollection[T]) Each(fn func(T)) {
+	for item := range l.seq {
+		fn(item)
+	}
+}
+
+// LazyMap 对惰性集合中的每个元素惰性应用回调函数
+func LazyMap[T, U any](l *LazyCollection[T], fn func(T) U) *LazyCollection[U] {
+	return &LazyCollection[U]{seq: func(yield func(U) bool) {
+		for item := range l.seq {
+			if !yield(fn(item)) {
+				return
+			}
+		}
+	}}
+}
+
+// LazyFlatMap 对惰性集合应用映射函数，然后惰性扁平化结果
+func LazyFlatMap[T, U any](l *LazyCollection[T], fn func(T) []U) *LazyCollection[U] {
+	return &LazyCollection[U]{seq: func(yield func(U) bool) {
+		for item := range l.seq {
+			for _, mapped := range fn(item) {
+				if !yield(mapped) {
+					return
+				}
+			}
+		}
+	}}
+}
+
+// LazyReduce 执行惰性集合并将其缩减为单个值
+func LazyReduce[T, U any](l *LazyCollection[T], fn func(U, T) U, initial U) U {
+	result := initial
+	for item := range l.seq {
+		result = fn(result, item)
+	}
+	return result
+}
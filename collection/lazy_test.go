@@ -0,0 +1,83 @@
+package collection
+
+import "testing"
+
+func TestLazyChainStopsAfterTake(t *testing.T) {
+	pulled := 0
+	source := NewLazy(func(yield func(int) bool) {
+		for i := 1; i <= 1000000; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	result := source.
+		Filter(func(n int) bool { return n%2 == 0 }).
+		Skip(1).
+		Take(2).
+		All()
+
+	if len(result) != 2 || result[0] != 4 || result[1] != 6 {
+		t.Errorf("Expected [4 6], got %v", result)
+	}
+	if pulled != 6 {
+		t.Errorf("Expected 6 items pulled from source, got %d", pulled)
+	}
+}
+
+func TestLazyIsDeferred(t *testing.T) {
+	calls := 0
+	lazy := New(1, 2, 3).Lazy().Filter(func(n int) bool {
+		calls++
+		return true
+	})
+	if calls != 0 {
+		t.Errorf("Expected no evaluation before terminal call, got %d calls", calls)
+	}
+	if lazy.Count() != 3 || calls != 3 {
+		t.Errorf("Expected 3 evaluations after Count, got %d", calls)
+	}
+}
+
+func TestLazyTakeNegative(t *testing.T) {
+	all := New(1, 2, 3, 4, 5).Lazy().Take(-2).All()
+	if len(all) != 2 || all[0] != 4 || all[1] != 5 {
+		t.Errorf("Expected [4 5], got %v", all)
+	}
+
+	all = New(1, 2).Lazy().Take(-5).All()
+	if len(all) != 2 || all[0] != 1 || all[1] != 2 {
+		t.Errorf("Expected [1 2], got %v", all)
+	}
+}
+
+func TestLazyFirst(t *testing.T) {
+	first, ok := New(1, 2, 3).Lazy().Reject(func(n int) bool { return n < 2 }).First()
+	if !ok || first != 2 {
+		t.Errorf("Expected first element to be 2, got %d", first)
+	}
+
+	_, ok = New[int]().Lazy().First()
+	if ok {
+		t.Error("Expected no element in empty lazy collection")
+	}
+}
+
+func TestLazyMapFlatMapReduce(t *testing.T) {
+	lazy := New(1, 2, 3).Lazy()
+	doubled := LazyMap(lazy, func(n int) int { return n * 2 })
+	expanded := LazyFlatMap(doubled, func(n int) []int { return []int{n, n} })
+
+	sum := LazyReduce(expanded, func(acc, n int) int { return acc + n }, 0)
+	if sum != 24 {
+		t.Errorf("Expected sum 24, got %d", sum)
+	}
+
+	collected := expanded.Take(3).Collect()
+	all := collected.All()
+	if len(all) != 3 || all[0] != 2 || all[1] != 2 || all[2] != 4 {
+		t.Errorf("Expected [2 2 4], got %v", all)
+	}
+}
@@ -0,0 +1,266 @@
+package collection
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+)
+
+// MapCollection 保持插入顺序的键值集合，零值可以直接使用
+type MapCollection[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// NewMap 创建一个新的空键值集合
+func NewMap[K comparable, V any]() *MapCollection[K, V] {
+	return &MapCollection[K, V]{keys: make([]K, 0), values: make(map[K]V)}
+}
+
+// FromMap 从map创建键值集合，由于map无序，键的顺序不确定
+func FromMap[K comparable, V any](m map[K]V) *MapCollection[K, V] {
+	mc := NewMap[K, V]()
+	for key, value := range m {
+		mc.Put(key, value)
+	}
+	return mc
+}
+
+// Count 返回集合中的键值对数量
+func (m *MapCollection[K, V]) Count() int {
+	return len(m.keys)
+}
+
+// IsEmpty 检查集合是否为空
+func (m *MapCollection[K, V]) IsEmpty() bool {
+	return len(m.keys) == 0
+}
+
+// Has 检查集合是否包含给定的键
+func (m *MapCollection[K, V]) Has(key K) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+// Get 根据键获取值
+func (m *MapCollection[K, V]) Get(key K) (V, bool) {
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Put 设置键对应的值，新键追加到末尾，已有的键保持原有位置
+func (m *MapCollection[K, V]) Put(key K, value V) *MapCollection[K, V] {
+	if m.values == nil {
+		m.values = make(map[K]V)
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+	return m
+}
+
+// Forget 移除给定的键
+func (m *MapCollection[K, V]) Forget(keys ...K) *MapCollection[K, V] {
+	for _, key := range keys {
+		if _, ok := m.values[key]; !ok {
+			continue
+		}
+		delete(m.values, key)
+		m.keys = slices.DeleteFunc(m.keys, func(k K) bool { return k == key })
+	}
+	return m
+}
+
+// Only 返回只包含给定键的新集合，顺序与原集合一致
+func (m *MapCollection[K, V]) Only(keys ...K) *MapCollection[K, V] {
+	wanted := make(map[K]bool, len(keys))
+	for _, key := range keys {
+		wanted[key] = true
+	}
+	return m.FilterKeys(func(key K) bool { return wanted[key] })
+}
+
+// Except 返回排除给定键的新集合
+func (m *MapCollection[K, V]) Except(keys ...K) *MapCollection[K, V] {
+	excluded := make(map[K]bool, len(keys))
+	for _, key := range keys {
+		excluded[key] = true
+	}
+	return m.FilterKeys(func(key K) bool { return !excluded[key] })
+}
+
+// Keys 按插入顺序返回所有键
+func (m *MapCollection[K, V]) Keys() *Collection[K] {
+	keys := make([]K, len(m.keys))
+	copy(keys, m.keys)
+	return &Collection[K]{items: keys}
+}
+
+// Values 按插入顺序返回所有值
+func (m *MapCollection[K, V]) Values() *Collection[V] {
+	values := make([]V, len(m.keys))
+	for i, key := range m.keys {
+		values[i] = m.values[key]
+	}
+	return &Collection[V]{items: values}
+}
+
+// Entries 返回按插入顺序遍历键值对的迭代器
+func (m *MapCollection[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, key := range m.keys {
+			if !yield(key, m.values[key]) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap 将集合转换为map
+func (m *MapCollection[K, V]) ToMap() map[K]V {
+	result := make(map[K]V, len(m.keys))
+	for key, value := range m.values {
+		result[key] = value
+	}
+	return result
+}
+
+// Each 按插入顺序遍历每个键值对
+func (m *MapCollection[K, V]) Each(fn func(K, V)) *MapCollection[K, V] {
+	for _, key := range m.keys {
+		fn(key, m.values[key])
+	}
+	return m
+}
+
+// Filter 根据给定的回调函数过滤键值对
+func (m *MapCollection[K, V]) Filter(fn func(K, V) bool) *MapCollection[K, V] {
+	filtered := NewMap[K, V]()
+	for _, key := range m.keys {
+		if fn(key, m.values[key]) {
+			filtered.Put(key, m.values[key])
+		}
+	}
+	return filtered
+}
+
+// FilterKeys 根据键过滤集合
+func (m *MapCollection[K, V]) FilterKeys(fn func(K) bool) *MapCollection[K, V] {
+	return m.Filter(func(key K, _ V) bool {
+		return fn(key)
+	})
+}
+
+// Merge 合并多个集合，后出现的值覆盖先出现的值，已有的键保持原有位置
+func (m *MapCollection[K, V]) Merge(others ...*MapCollection[K, V]) *MapCollection[K, V] {
+	merged := m.Clone()
+	for _, other := range others {
+		for _, key := range other.keys {
+			merged.Put(key, other.values[key])
+		}
+	}
+	return merged
+}
+
+// Clone 克隆集合
+func (m *MapCollection[K, V]) Clone() *MapCollection[K, V] {
+	cloned := &MapCollection[K, V]{keys: make([]K, len(m.keys)), values: make(map[K]V, len(m.keys))}
+	copy(cloned.keys, m.keys)
+	for key, value := range m.values {
+		cloned.values[key] = value
+	}
+	return cloned
+}
+
+// ToJSON 将集合转换为保持插入顺序的JSON对象
+func (m *MapCollection[K, V]) ToJSON() (string, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyData, err := jsonObjectKey(key)
+		if err != nil {
+			return "", err
+		}
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		valueData, err := json.Marshal(m.values[key])
+		if err != nil {
+			return "", err
+		}
+		buf.Write(valueData)
+	}
+	buf.WriteByte('}')
+	return buf.String(), nil
+}
+
+// jsonObjectKey 将键编码为JSON对象的键，非字符串的键会被转为字符串
+func jsonObjectKey(key any) ([]byte, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 && data[0] == '"' {
+		return data, nil
+	}
+	if bytes.HasPrefix(data, []byte("{")) || bytes.HasPrefix(data, []byte("[")) {
+		return nil, fmt.Errorf("collection: unsupported JSON object key type %T", key)
+	}
+	return []byte(strconv.Quote(string(data))), nil
+}
+
+// String 实现Stringer接口
+func (m *MapCollection[K, V]) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("map[")
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		fmt.Fprintf(&buf, "%v:%v", key, m.values[key])
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// MapValues 对每个值应用回调函数，键和顺序保持不变
+func MapValues[K comparable, V, U any](m *MapCollection[K, V], fn func(K, V) U) *MapCollection[K, U] {
+	mapped := &MapCollection[K, U]{keys: make([]K, len(m.keys)), values: make(map[K]U, len(m.keys))}
+	copy(mapped.keys, m.keys)
+	for _, key := range m.keys {
+		mapped.values[key] = fn(key, m.values[key])
+	}
+	return mapped
+}
+
+// Flip 交换键和值，值重复时保留最后一个键
+func Flip[K, V comparable](m *MapCollection[K, V]) *MapCollection[V, K] {
+	flipped := NewMap[V, K]()
+	for _, key := range m.keys {
+		flipped.Put(m.values[key], key)
+	}
+	return flipped
+}
+
+// SortKeys 返回按键升序排列的新集合
+func SortKeys[K cmp.Ordered, V any](m *MapCollection[K, V]) *MapCollection[K, V] {
+	sorted := m.Clone()
+	slices.Sort(sorted.keys)
+	return sorted
+}
+
+// GroupByMap 根据给定的键对集合进行分组，分组按键首次出现的顺序排列
+func GroupByMap[T any, K comparable](c *Collection[T], fn func(T) K) *MapCollection[K, *Collection[T]] {
+	groups := NewMap[K, *Collection[T]]()
+	for key, group := range GroupBySeq(c, fn) {
+		groups.Put(key, group)
+	}
+	return groups
+}
@@ -0,0 +1,120 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMapCollectionPutGetForget(t *testing.T) {
+	m := NewMap[string, int]().Put("b", 2).Put("a", 1).Put("c", 3).Put("b", 20)
+
+	if m.Count() != 3 {
+		t.Errorf("Expected count 3, got %d", m.Count())
+	}
+	if value, ok := m.Get("b"); !ok || value != 20 {
+		t.Errorf("Expected b=20, got %d", value)
+	}
+	if !slices.Equal(m.Keys().All(), []string{"b", "a", "c"}) {
+		t.Errorf("Expected insertion order [b a c], got %v", m.Keys().All())
+	}
+
+	m.Forget("a", "missing")
+	if m.Has("a") || !slices.Equal(m.Keys().All(), []string{"b", "c"}) {
+		t.Errorf("Expected a to be forgotten, got %v", m.Keys().All())
+	}
+	if !slices.Equal(m.Values().All(), []int{20, 3}) {
+		t.Errorf("Expected values [20 3], got %v", m.Values().All())
+	}
+}
+
+func TestMapCollectionZeroValue(t *testing.T) {
+	var m MapCollection[string, int]
+	if m.Has("a") || m.Count() != 0 {
+		t.Errorf("Expected zero value to be empty, got %v", m.String())
+	}
+	m.Forget("a")
+	m.Put("a", 1).Put("b", 2)
+	if value, ok := m.Get("b"); !ok || value != 2 {
+		t.Errorf("Expected b=2, got %d %v", value, ok)
+	}
+	if keys := m.Keys().All(); !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("Expected keys [a b], got %v", keys)
+	}
+}
+
+func TestMapCollectionOnlyExcept(t *testing.T) {
+	m := NewMap[string, int]().Put("a", 1).Put("b", 2).Put("c", 3)
+
+	only := m.Only("c", "a")
+	if !slices.Equal(only.Keys().All(), []string{"a", "c"}) {
+		t.Errorf("Expected [a c], got %v", only.Keys().All())
+	}
+	except := m.Except("b")
+	if !slices.Equal(except.Keys().All(), []string{"a", "c"}) {
+		t.Errorf("Expected [a c], got %v", except.Keys().All())
+	}
+	if m.Count() != 3 {
+		t.Errorf("Expected original to be unchanged, got %d", m.Count())
+	}
+}
+
+func TestMapCollectionMapValuesFilterKeys(t *testing.T) {
+	m := NewMap[string, int]().Put("x", 1).Put("y", 2)
+	labels := MapValues(m, func(k string, v int) string { return k + "=" + string(rune('0'+v)) })
+	if !slices.Equal(labels.Values().All(), []string{"x=1", "y=2"}) {
+		t.Errorf("MapValues failed, got %v", labels.Values().All())
+	}
+
+	filtered := m.FilterKeys(func(k string) bool { return k == "y" })
+	if filtered.Count() != 1 || !filtered.Has("y") {
+		t.Errorf("FilterKeys failed, got %v", filtered)
+	}
+}
+
+func TestMapCollectionFlipMergeSortKeys(t *testing.T) {
+	m := NewMap[string, int]().Put("c", 3).Put("a", 1)
+	flipped := Flip(m)
+	if key, _ := flipped.Get(3); key != "c" {
+		t.Errorf("Expected 3 -> c, got %s", key)
+	}
+
+	merged := m.Merge(NewMap[string, int]().Put("a", 10).Put("b", 2))
+	if !slices.Equal(merged.Keys().All(), []string{"c", "a", "b"}) || !slices.Equal(merged.Values().All(), []int{3, 10, 2}) {
+		t.Errorf("Merge failed, got %v", merged)
+	}
+	if value, _ := m.Get("a"); value != 1 {
+		t.Errorf("Expected Merge not to modify receiver, got a=%d", value)
+	}
+
+	sorted := SortKeys(merged)
+	if !slices.Equal(sorted.Keys().All(), []string{"a", "b", "c"}) {
+		t.Errorf("Expected sorted keys [a b c], got %v", sorted.Keys().All())
+	}
+}
+
+func TestMapCollectionEntriesAndJSON(t *testing.T) {
+	m := NewMap[int, string]().Put(2, "two").Put(1, "one")
+	keys := make([]int, 0)
+	for key := range m.Entries() {
+		keys = append(keys, key)
+	}
+	if !slices.Equal(keys, []int{2, 1}) {
+		t.Errorf("Expected [2 1], got %v", keys)
+	}
+
+	jsonStr, err := m.ToJSON()
+	if err != nil || jsonStr != `{"2":"two","1":"one"}` {
+		t.Errorf("Expected ordered JSON, got %s %v", jsonStr, err)
+	}
+}
+
+func TestGroupByMap(t *testing.T) {
+	c := New("apple", "banana", "avocado", "cherry")
+	groups := GroupByMap(c, func(s string) byte { return s[0] })
+	if !slices.Equal(groups.Keys().All(), []byte("abc")) {
+		t.Errorf("Expected keys abc, got %v", groups.Keys().All())
+	}
+	if group, _ := groups.Get('a'); group.Count() != 2 {
+		t.Errorf("Expected 2 items in group a, got %d", group.Count())
+	}
+}
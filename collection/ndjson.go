@@ -0,0 +1,102 @@
+package collection
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNotJSONArray JSON流的顶层不是数组
+var ErrNotJSONArray = errors.New("collection: top-level JSON value is not an array")
+
+// FromJSONReader 使用 json.Decoder 逐个元素解码顶层JSON数组，不需要将整个输入读入内存
+// 顶层为 null 时返回空集合；元素解码失败时返回包含元素索引的 *IndexError
+func FromJSONReader[T any](r io.Reader) (*Collection[T], error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return &Collection[T]{items: []T{}}, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("%w, got %v", ErrNotJSONArray, token)
+	}
+
+	items := make([]T, 0)
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return nil, &IndexError{Index: len(items), Err: err}
+		}
+		items = append(items, item)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return &Collection[T]{items: items}, nil
+}
+
+// FromNDJSON 逐行解码 NDJSON / JSON Lines 流，空行被忽略
+// 解码失败时返回包含行号的 *LineError；CollectErrors 模式下跳过错误行，返回成功解码的元素及合并后的错误
+func FromNDJSON[T any](r io.Reader, mode ErrorMode) (*Collection[T], error) {
+	reader := bufio.NewReader(r)
+	items := make([]T, 0)
+	var errs []error
+	for line := 1; ; line++ {
+		data, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, readErr
+		}
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			var item T
+			if err := json.Unmarshal(data, &item); err != nil {
+				lineErr := &LineError{Line: line, Err: err}
+				if mode != CollectErrors {
+					return nil, lineErr
+				}
+				errs = append(errs, lineErr)
+			} else {
+				items = append(items, item)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+	}
+	return &Collection[T]{items: items}, errors.Join(errs...)
+}
+
+// WriteJSON 将集合作为JSON数组逐个元素写入w，输出与 ToJSON 相同
+func (c *Collection[T]) WriteJSON(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	writer.WriteByte('[')
+	for i, item := range c.items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+		if i > 0 {
+			writer.WriteByte(',')
+		}
+		writer.Write(data)
+	}
+	writer.WriteByte(']')
+	return writer.Flush()
+}
+
+// WriteNDJSON 将集合写入为 NDJSON / JSON Lines，每个元素一行
+func (c *Collection[T]) WriteNDJSON(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	encoder := json.NewEncoder(writer)
+	for i, item := range c.items {
+		if err := encoder.Encode(item); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,115 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type ndjsonEvent struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+}
+
+func TestFromJSONReader(t *testing.T) {
+	input := `[
+		{"id": 1, "kind": "click"},
+		{"id": 2, "kind": "view"}
+	]`
+	events, err := FromJSONReader[ndjsonEvent](strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []ndjsonEvent{{1, "click"}, {2, "view"}}
+	if !slices.Equal(events.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, events.All())
+	}
+
+	empty, err := FromJSONReader[int](strings.NewReader("null"))
+	if err != nil || empty.Count() != 0 {
+		t.Errorf("Expected empty collection for null, got %v (%v)", empty, err)
+	}
+	if _, err := FromJSONReader[int](strings.NewReader(`{"a": 1}`)); !errors.Is(err, ErrNotJSONArray) {
+		t.Errorf("Expected not array error, got %v", err)
+	}
+
+	_, err = FromJSONReader[int](strings.NewReader(`[1, 2, "three", 4]`))
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) || indexErr.Index != 2 {
+		t.Errorf("Expected error at index 2, got %v", err)
+	}
+	if _, err := FromJSONReader[int](strings.NewReader(`[1, 2`)); err == nil {
+		t.Error("Expected error for truncated input")
+	}
+}
+
+func TestFromNDJSON(t *testing.T) {
+	input := "{\"id\":1,\"kind\":\"click\"}\n\n{\"id\":2,\"kind\":\"view\"}\r\n{\"id\":3}"
+	events, err := FromNDJSON[ndjsonEvent](strings.NewReader(input), StopOnError)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []ndjsonEvent{{1, "click"}, {2, "view"}, {3, ""}}
+	if !slices.Equal(events.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, events.All())
+	}
+}
+
+func TestFromNDJSONErrors(t *testing.T) {
+	input := "{\"id\":1}\n{\"id\":\n{\"id\":3}\n{\"id\":\"four\"}\n"
+
+	_, err := FromNDJSON[ndjsonEvent](strings.NewReader(input), StopOnError)
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 2 {
+		t.Errorf("Expected error on line 2, got %v", err)
+	}
+
+	events, err := FromNDJSON[ndjsonEvent](strings.NewReader(input), CollectErrors)
+	if ids := Map(events, func(e ndjsonEvent) int { return e.ID }).All(); !slices.Equal(ids, []int{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", ids)
+	}
+	if err == nil || !strings.Contains(err.Error(), "line 2") || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("Expected errors for lines 2 and 4, got %v", err)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	events := New(ndjsonEvent{1, "<click>"}, ndjsonEvent{2, "view"})
+	var buf bytes.Buffer
+	if err := events.WriteJSON(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected, _ := events.ToJSON()
+	if buf.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := New[int]().WriteJSON(&buf); err != nil || buf.String() != "[]" {
+		t.Errorf("Expected [], got %s (%v)", buf.String(), err)
+	}
+
+	var indexErr *IndexError
+	if err := New[any](1, make(chan int)).WriteJSON(&buf); !errors.As(err, &indexErr) || indexErr.Index != 1 {
+		t.Errorf("Expected error at index 1, got %v", err)
+	}
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	events := New(ndjsonEvent{1, "click"}, ndjsonEvent{2, "view"})
+	var buf bytes.Buffer
+	if err := events.WriteNDJSON(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "{\"id\":1,\"kind\":\"click\"}\n{\"id\":2,\"kind\":\"view\"}\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	decoded, err := FromNDJSON[ndjsonEvent](&buf, StopOnError)
+	if err != nil || !slices.Equal(decoded.All(), events.All()) {
+		t.Errorf("Expected round trip, got %v (%v)", decoded.All(), err)
+	}
+}
@@ -0,0 +1,178 @@
+package collection
+
+import (
+	"cmp"
+	"errors"
+	"math"
+)
+
+// ErrOverflow 整数求和溢出
+var ErrOverflow = errors.New("collection: integer overflow")
+
+// Integer 所有整数类型的约束
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float 所有浮点数类型的约束
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number 所有数值类型的约束
+type Number interface {
+	Integer | Float
+}
+
+// isFloat 判断N是否为浮点类型
+func isFloat[N Number]() bool {
+	one, two := N(1), N(2)
+	return one/two != 0
+}
+
+// kahan 使用 Neumaier 改进的 Kahan 补偿求和
+type kahan struct {
+	sum, compensation float64
+}
+
+// add 累加一个值，总和变为 ±Inf 或 NaN 后不再补偿，结果与普通求和一致
+func (k *kahan) add(value float64) {
+	t := k.sum + value
+	if math.IsInf(t, 0) || math.IsNaN(t) {
+		k.sum = t
+		return
+	}
+	if math.Abs(k.sum) >= math.Abs(value) {
+		k.compensation += (k.sum - t) + value
+	} else {
+		k.compensation += (value - t) + k.sum
+	}
+	k.sum = t
+}
+
+// result 返回补偿后的总和
+func (k *kahan) result() float64 {
+	if math.IsInf(k.sum, 0) || math.IsNaN(k.sum) {
+		return k.sum
+	}
+	return k.sum + k.compensation
+}
+
+// SumOf 以原生类型计算集合元素的总和，浮点类型使用补偿求和，整数溢出时按原生类型回绕
+func SumOf[T any, N Number](c *Collection[T], fn func(T) N) N {
+	if isFloat[N]() {
+		var k kahan
+		for _, item := range c.items {
+			k.add(float64(fn(item)))
+		}
+		return N(k.result())
+	}
+	var sum N
+	for _, item := range c.items {
+		sum += fn(item)
+	}
+	return sum
+}
+
+// SumOfChecked 以原生类型计算整数总和，溢出时返回 ErrOverflow
+func SumOfChecked[T any, N Integer](c *Collection[T], fn func(T) N) (N, error) {
+	var sum N
+	for _, item := range c.items {
+		value := fn(item)
+		next := sum + value
+		if (value > 0 && next < sum) || (value < 0 && next > sum) {
+			return sum, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// AvgOf 计算集合元素的平均值，整数以原生精度求和后只做一次除法，浮点数使用补偿求和
+func AvgOf[T any, N Number](c *Collection[T], fn func(T) N) float64 {
+	if len(c.items) == 0 {
+		return 0
+	}
+	if !isFloat[N]() {
+		if avg, ok := avgOfIntegers(c, fn); ok {
+			return avg
+		}
+	}
+	var k kahan
+	for _, item := range c.items {
+		k.add(float64(fn(item)))
+	}
+	return k.result() / float64(len(c.items))
+}
+
+// avgOfIntegers 以 int64 或 uint64 精确求和后计算平均值，总和溢出时返回false
+func avgOfIntegers[T any, N Number](c *Collection[T], fn func(T) N) (float64, bool) {
+	count := len(c.items)
+	if N(0)-1 < 0 {
+		var sum int64
+		for _, item := range c.items {
+			value := int64(fn(item))
+			next := sum + value
+			if (value > 0 && next < sum) || (value < 0 && next > sum) {
+				return 0, false
+			}
+			sum = next
+		}
+		quotient, remainder := sum/int64(count), sum%int64(count)
+		return float64(quotient) + float64(remainder)/float64(count), true
+	}
+	var sum uint64
+	for _, item := range c.items {
+		next := sum + uint64(fn(item))
+		if next < sum {
+			return 0, false
+		}
+		sum = next
+	}
+	quotient, remainder := sum/uint64(count), sum%uint64(count)
+	return float64(quotient) + float64(remainder)/float64(count), true
+}
+
+// MinOf 以原生类型获取集合中的最小值
+func MinOf[T any, N cmp.Ordered](c *Collection[T], fn func(T) N) (N, bool) {
+	_, value, ok := extremeBy(c, fn, func(a, b N) bool { return a < b })
+	return value, ok
+}
+
+// MaxOf 以原生类型获取集合中的最大值
+func MaxOf[T any, N cmp.Ordered](c *Collection[T], fn func(T) N) (N, bool) {
+	_, value, ok := extremeBy(c, fn, func(a, b N) bool { return a > b })
+	return value, ok
+}
+
+// MinBy 获取键最小的元素，键相同时返回第一个
+func MinBy[T any, K cmp.Ordered](c *Collection[T], fn func(T) K) (T, bool) {
+	item, _, ok := extremeBy(c, fn, func(a, b K) bool { return a < b })
+	return item, ok
+}
+
+// MaxBy 获取键最大的元素，键相同时返回第一个
+func MaxBy[T any, K cmp.Ordered](c *Collection[T], fn func(T) K) (T, bool) {
+	item, _, ok := extremeBy(c, fn, func(a, b K) bool { return a > b })
+	return item, ok
+}
+
+// extremeBy 返回键最先满足better的元素及其键
+func extremeBy[T any, K cmp.Ordered](c *Collection[T], fn func(T) K, better func(K, K) bool) (T, K, bool) {
+	if len(c.items) == 0 {
+		var zero T
+		var zeroKey K
+		return zero, zeroKey, false
+	}
+	bestItem := c.items[0]
+	bestKey := fn(bestItem)
+	for _, item := range c.items[1:] {
+		key := fn(item)
+		if better(key, bestKey) {
+			bestKey = key
+			bestItem = item
+		}
+	}
+	return bestItem, bestKey, true
+}
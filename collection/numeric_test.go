@@ -0,0 +1,139 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSumOfInt64Precision(t *testing.T) {
+	c := New[int64](1<<53, 1, 1)
+	sum := SumOf(c, func(n int64) int64 { return n })
+	if sum != 1<<53+2 {
+		t.Errorf("Expected %d, got %d", int64(1<<53+2), sum)
+	}
+}
+
+func TestSumOfFloatCompensated(t *testing.T) {
+	c := New(1e16, 1.0, -1e16)
+	sum := SumOf(c, func(f float64) float64 { return f })
+	if sum != 1.0 {
+		t.Errorf("Expected compensated sum 1, got %v", sum)
+	}
+}
+
+func TestSumOfChecked(t *testing.T) {
+	sum, err := SumOfChecked(New[int8](100, 20, 7), func(n int8) int8 { return n })
+	if err != nil || sum != 127 {
+		t.Errorf("Expected 127, got %d %v", sum, err)
+	}
+
+	_, err = SumOfChecked(New[int8](100, 28), func(n int8) int8 { return n })
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow, got %v", err)
+	}
+
+	_, err = SumOfChecked(New[int64](math.MinInt64, -1), func(n int64) int64 { return n })
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow for negative overflow, got %v", err)
+	}
+
+	_, err = SumOfChecked(New[uint8](200, 100), func(n uint8) uint8 { return n })
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow for unsigned overflow, got %v", err)
+	}
+}
+
+func TestAvgOf(t *testing.T) {
+	avg := AvgOf(New(1, 2, 3, 4), func(n int) int { return n })
+	if avg != 2.5 {
+		t.Errorf("Expected 2.5, got %v", avg)
+	}
+	if AvgOf(New[int](), func(n int) int { return n }) != 0 {
+		t.Error("Expected 0 for empty collection")
+	}
+}
+
+func TestAvgOfLargeIntegers(t *testing.T) {
+	c := New[int64](1<<62+1, 1<<62+3)
+	if avg := AvgOf(c, func(n int64) int64 { return n }); avg != float64(1<<62+2) {
+		t.Errorf("Expected %v, got %v", float64(1<<62+2), avg)
+	}
+
+	big := int64(1<<53 + 1)
+	if avg := AvgOf(New(big, big), func(n int64) int64 { return n }); avg != float64(big) {
+		t.Errorf("Expected %v, got %v", float64(big), avg)
+	}
+
+	if avg := AvgOf(New[int8](100, 100, 100), func(n int8) int8 { return n }); avg != 100 {
+		t.Errorf("Expected 100 without int8 overflow, got %v", avg)
+	}
+
+	if avg := AvgOf(New[uint64](math.MaxUint64, math.MaxUint64), func(n uint64) uint64 { return n }); avg != math.MaxUint64 {
+		t.Errorf("Expected fallback average %v, got %v", float64(math.MaxUint64), avg)
+	}
+}
+
+func TestSumNonFinite(t *testing.T) {
+	id := func(f float64) float64 { return f }
+	tests := []struct {
+		values   []float64
+		expected float64
+	}{
+		{[]float64{1, math.Inf(1)}, math.Inf(1)},
+		{[]float64{1e308, 1e308}, math.Inf(1)},
+		{[]float64{-1e308, -1e308, 1}, math.Inf(-1)},
+		{[]float64{math.Inf(1), math.Inf(-1)}, math.NaN()},
+		{[]float64{1, math.NaN()}, math.NaN()},
+	}
+	for _, tt := range tests {
+		sum := Sum(New(tt.values...), id)
+		avg := Avg(New(tt.values...), id)
+		if math.IsNaN(tt.expected) {
+			if !math.IsNaN(sum) || !math.IsNaN(avg) {
+				t.Errorf("Sum(%v): expected NaN, got %v and %v", tt.values, sum, avg)
+			}
+			continue
+		}
+		if sum != tt.expected || avg != tt.expected {
+			t.Errorf("Sum(%v): expected %v, got %v and %v", tt.values, tt.expected, sum, avg)
+		}
+	}
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	type Account struct {
+		ID    int64
+		Cents int64
+	}
+	c := New(Account{1, 500}, Account{2, math.MaxInt64}, Account{3, -7})
+
+	minCents, ok := MinOf(c, func(a Account) int64 { return a.Cents })
+	if !ok || minCents != -7 {
+		t.Errorf("Expected -7, got %d", minCents)
+	}
+	maxCents, ok := MaxOf(c, func(a Account) int64 { return a.Cents })
+	if !ok || maxCents != math.MaxInt64 {
+		t.Errorf("Expected MaxInt64, got %d", maxCents)
+	}
+
+	names := New("bob", "alice", "carol")
+	first, _ := MinOf(names, func(s string) string { return s })
+	if first != "alice" {
+		t.Errorf("Expected alice, got %s", first)
+	}
+
+	_, ok = MaxOf(New[int](), func(n int) int { return n })
+	if ok {
+		t.Error("Expected no value in empty collection")
+	}
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	c := New("aa", "b", "cc", "d")
+	shortest, _ := MinBy(c, func(s string) int { return len(s) })
+	longest, _ := MaxBy(c, func(s string) int { return len(s) })
+	if shortest != "b" || longest != "aa" {
+		t.Errorf("Expected first of ties b and aa, got %s and %s", shortest, longest)
+	}
+}
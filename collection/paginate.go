@@ -0,0 +1,240 @@
+package collection
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// DefaultPerPage perPage小于等于0时使用的默认每页数量
+const DefaultPerPage = 15
+
+// ErrInvalidCursor 游标无法解码
+var ErrInvalidCursor = errors.New("collection: invalid cursor")
+
+// Page 分页结果，页码从1开始，From/To 为当前页元素在整个集合中的位置（从1开始）
+// NextPage、PrevPage、From、To 为0表示不存在，序列化为JSON时输出null
+type Page[T any] struct {
+	Items       []T
+	Total       int
+	PerPage     int
+	CurrentPage int
+	LastPage    int
+	From        int
+	To          int
+	NextPage    int
+	PrevPage    int
+}
+
+// HasNext 是否存在下一页
+func (p *Page[T]) HasNext() bool {
+	return p.NextPage > 0
+}
+
+// HasPrev 是否存在上一页
+func (p *Page[T]) HasPrev() bool {
+	return p.PrevPage > 0
+}
+
+// Collection 将当前页的元素转换为集合
+func (p *Page[T]) Collection() *Collection[T] {
+	return &Collection[T]{items: p.Items}
+}
+
+// MarshalJSON 实现json.Marshaler接口，输出标准分页信封
+func (p *Page[T]) MarshalJSON() ([]byte, error) {
+	items := p.Items
+	if items == nil {
+		items = []T{}
+	}
+	return json.Marshal(struct {
+		Data        []T  `json:"data"`
+		Total       int  `json:"total"`
+		PerPage     int  `json:"per_page"`
+		CurrentPage int  `json:"current_page"`
+		LastPage    int  `json:"last_page"`
+		From        *int `json:"from"`
+		To          *int `json:"to"`
+		NextPage    *int `json:"next_page"`
+		PrevPage    *int `json:"prev_page"`
+	}{
+		Data:        items,
+		Total:       p.Total,
+		PerPage:     p.PerPage,
+		CurrentPage: p.CurrentPage,
+		LastPage:    p.LastPage,
+		From:        nullablePage(p.From),
+		To:          nullablePage(p.To),
+		NextPage:    nullablePage(p.NextPage),
+		PrevPage:    nullablePage(p.PrevPage),
+	})
+}
+
+// ToJSON 将分页结果转换为JSON字符串
+func (p *Page[T]) ToJSON() (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// nullablePage 将0转换为nil
+func nullablePage(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+// ForPage 获取指定页的元素，page小于1时视为1，perPage小于等于0时使用 DefaultPerPage
+func (c *Collection[T]) ForPage(page, perPage int) *Collection[T] {
+	page, perPage = normalizePage(page, perPage)
+	return c.Skip(pageOffset(page, perPage, len(c.items))).Take(perPage)
+}
+
+// Paginate 获取指定页的元素及分页信息，page小于1时视为1，perPage小于等于0时使用 DefaultPerPage
+func (c *Collection[T]) Paginate(page, perPage int) *Page[T] {
+	page, perPage = normalizePage(page, perPage)
+	items := c.ForPage(page, perPage).items
+	total := len(c.items)
+	lastPage := max((total+perPage-1)/perPage, 1)
+
+	p := &Page[T]{
+		Items:       items,
+		Total:       total,
+		PerPage:     perPage,
+		CurrentPage: page,
+		LastPage:    lastPage,
+	}
+	if len(items) > 0 {
+		p.From = pageOffset(page, perPage, total) + 1
+		p.To = p.From + len(items) - 1
+	}
+	if page < lastPage {
+		p.NextPage = page + 1
+	}
+	if page > 1 {
+		p.PrevPage = page - 1
+	}
+	return p
+}
+
+// normalizePage 规范化页码和每页数量
+func normalizePage(page, perPage int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = DefaultPerPage
+	}
+	return page, perPage
+}
+
+// pageOffset 计算页的起始偏移，页码过大时返回total，避免 (page-1)*perPage 溢出
+func pageOffset(page, perPage, total int) int {
+	if page-1 > total/perPage {
+		return total
+	}
+	return (page - 1) * perPage
+}
+
+// CursorPage 游标分页结果，NextCursor 为空表示没有更多数据
+type CursorPage[T any] struct {
+	Items      []T    `json:"data"`
+	PerPage    int    `json:"per_page"`
+	NextCursor string `json:"next_cursor"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// Collection 将当前页的元素转换为集合
+func (p *CursorPage[T]) Collection() *Collection[T] {
+	return &Collection[T]{items: p.Items}
+}
+
+// ToJSON 将游标分页结果转换为JSON字符串
+func (p *CursorPage[T]) ToJSON() (string, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// CursorPaginate 基于游标的分页，集合需要按keyFn升序排列，键可以重复
+// after 为上一页返回的 NextCursor，为空时从头开始；limit小于等于0时使用 DefaultPerPage
+// 游标记录最后一个元素的键以及该键已经返回的元素数量，因此键相同的元素跨页时不会丢失
+func CursorPaginate[T any, K cmp.Ordered](c *Collection[T], keyFn func(T) K, after string, limit int) (*CursorPage[T], error) {
+	if limit <= 0 {
+		limit = DefaultPerPage
+	}
+
+	start := 0
+	if after != "" {
+		position, err := decodeCursor[K](after)
+		if err != nil {
+			return nil, err
+		}
+		for start < len(c.items) && keyFn(c.items[start]) < position.Key {
+			start++
+		}
+		for skipped := 0; skipped < position.Seen && start < len(c.items) && keyFn(c.items[start]) == position.Key; skipped++ {
+			start++
+		}
+	}
+
+	end := min(start+limit, len(c.items))
+	items := make([]T, end-start)
+	copy(items, c.items[start:end])
+	page := &CursorPage[T]{
+		Items:   items,
+		PerPage: limit,
+		HasMore: end < len(c.items),
+	}
+	if page.HasMore {
+		last := keyFn(items[len(items)-1])
+		seen := 0
+		for i := end - 1; i >= 0 && keyFn(c.items[i]) == last; i-- {
+			seen++
+		}
+		cursor, err := encodeCursor(cursorPosition[K]{Key: last, Seen: seen})
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = cursor
+	}
+	return page, nil
+}
+
+// cursorPosition 游标的内容：最后一个元素的键及该键已经返回的元素数量
+type cursorPosition[K any] struct {
+	Key  K   `json:"k"`
+	Seen int `json:"n"`
+}
+
+// encodeCursor 将游标位置编码为不透明的字符串
+func encodeCursor[K any](position cursorPosition[K]) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor 将游标解码为位置
+func decodeCursor[K any](cursor string) (cursorPosition[K], error) {
+	var position cursorPosition[K]
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return position, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if err := json.Unmarshal(data, &position); err != nil {
+		return position, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if position.Seen < 0 {
+		return position, fmt.Errorf("%w: negative position", ErrInvalidCursor)
+	}
+	return position, nil
+}
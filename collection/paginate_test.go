@@ -0,0 +1,157 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestForPage(t *testing.T) {
+	c := New(1, 2, 3, 4, 5, 6, 7)
+	if !slices.Equal(c.ForPage(2, 3).All(), []int{4, 5, 6}) {
+		t.Errorf("Expected [4 5 6], got %v", c.ForPage(2, 3).All())
+	}
+	if !slices.Equal(c.ForPage(0, 3).All(), []int{1, 2, 3}) {
+		t.Errorf("Expected page 0 to be treated as 1, got %v", c.ForPage(0, 3).All())
+	}
+	if !c.ForPage(4, 3).IsEmpty() {
+		t.Errorf("Expected empty page, got %v", c.ForPage(4, 3).All())
+	}
+}
+
+func TestPaginateHugePage(t *testing.T) {
+	c := New(1, 2, 3)
+	if items := c.ForPage(math.MaxInt/2+2, 2).All(); len(items) != 0 {
+		t.Errorf("Expected empty page, got %v", items)
+	}
+	page := c.Paginate(math.MaxInt/2+2, 2)
+	if len(page.Items) != 0 || page.From != 0 || page.To != 0 || page.NextPage != 0 {
+		t.Errorf("Expected empty page without overflow, got %+v", page)
+	}
+	if items := c.ForPage(2, math.MaxInt).All(); len(items) != 0 {
+		t.Errorf("Expected empty page for huge perPage, got %v", items)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	c := New(1, 2, 3, 4, 5, 6, 7)
+	p := c.Paginate(3, 3)
+	if !slices.Equal(p.Items, []int{7}) || p.Total != 7 || p.LastPage != 3 {
+		t.Errorf("Unexpected page %+v", p)
+	}
+	if p.From != 7 || p.To != 7 || p.HasNext() || p.PrevPage != 2 {
+		t.Errorf("Unexpected page metadata %+v", p)
+	}
+
+	first := c.Paginate(1, 3)
+	if first.From != 1 || first.To != 3 || first.NextPage != 2 || first.HasPrev() {
+		t.Errorf("Unexpected first page metadata %+v", first)
+	}
+
+	empty := New[int]().Paginate(1, 0)
+	if empty.PerPage != DefaultPerPage || empty.LastPage != 1 || empty.From != 0 || empty.HasNext() {
+		t.Errorf("Unexpected empty page %+v", empty)
+	}
+}
+
+func TestPageJSON(t *testing.T) {
+	jsonStr, err := New(1, 2, 3).Paginate(2, 2).ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON failed: %v", err)
+	}
+	expected := `{"data":[3],"total":3,"per_page":2,"current_page":2,"last_page":2,"from":3,"to":3,"next_page":null,"prev_page":1}`
+	if jsonStr != expected {
+		t.Errorf("Expected %s, got %s", expected, jsonStr)
+	}
+
+	jsonStr, _ = New[int]().Paginate(1, 2).ToJSON()
+	expected = `{"data":[],"total":0,"per_page":2,"current_page":1,"last_page":1,"from":null,"to":null,"next_page":null,"prev_page":null}`
+	if jsonStr != expected {
+		t.Errorf("Expected %s, got %s", expected, jsonStr)
+	}
+}
+
+func TestCursorPaginate(t *testing.T) {
+	type Row struct {
+		ID   int
+		Name string
+	}
+	c := New(Row{1, "a"}, Row{2, "b"}, Row{5, "c"}, Row{8, "d"}, Row{9, "e"})
+	key := func(r Row) int { return r.ID }
+
+	ids := make([]int, 0)
+	cursor := ""
+	pages := 0
+	for {
+		page, err := CursorPaginate(c, key, cursor, 2)
+		if err != nil {
+			t.Fatalf("CursorPaginate failed: %v", err)
+		}
+		pages++
+		for _, row := range page.Items {
+			ids = append(ids, row.ID)
+		}
+		if !page.HasMore {
+			if page.NextCursor != "" {
+				t.Errorf("Expected empty cursor on last page, got %s", page.NextCursor)
+			}
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if pages != 3 || !slices.Equal(ids, []int{1, 2, 5, 8, 9}) {
+		t.Errorf("Expected 3 pages covering all ids, got %d pages %v", pages, ids)
+	}
+}
+
+func TestCursorPaginateDuplicateKeys(t *testing.T) {
+	type Row struct {
+		ID  int
+		Key int
+	}
+	c := New(Row{1, 1}, Row{2, 1}, Row{3, 1}, Row{4, 2}, Row{5, 2}, Row{6, 3})
+	key := func(r Row) int { return r.Key }
+
+	for limit := 1; limit <= 4; limit++ {
+		ids := make([]int, 0)
+		cursor := ""
+		for {
+			page, err := CursorPaginate(c, key, cursor, limit)
+			if err != nil {
+				t.Fatalf("CursorPaginate failed: %v", err)
+			}
+			for _, row := range page.Items {
+				ids = append(ids, row.ID)
+			}
+			if !page.HasMore {
+				break
+			}
+			cursor = page.NextCursor
+		}
+		if !slices.Equal(ids, []int{1, 2, 3, 4, 5, 6}) {
+			t.Errorf("limit %d: expected all rows exactly once, got %v", limit, ids)
+		}
+	}
+}
+
+func TestCursorPaginateInvalidCursor(t *testing.T) {
+	_, err := CursorPaginate(New(1, 2), func(n int) int { return n }, "!!not-a-cursor", 1)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+
+	cursor, _ := encodeCursor(cursorPosition[string]{Key: "text"})
+	_, err = CursorPaginate(New(1, 2), func(n int) int { return n }, cursor, 1)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor for mismatched key type, got %v", err)
+	}
+}
+
+func TestCursorPageJSON(t *testing.T) {
+	page, _ := CursorPaginate(New(1, 2, 3), func(n int) int { return n }, "", 2)
+	jsonStr, err := page.ToJSON()
+	if err != nil || jsonStr != `{"data":[1,2],"per_page":2,"next_cursor":"eyJrIjoyLCJuIjoxfQ","has_more":true}` {
+		t.Errorf("Unexpected cursor page JSON %s %v", jsonStr, err)
+	}
+}
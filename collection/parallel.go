@@ -0,0 +1,86 @@
+package collection
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelRun 使用最多workers个协程并发执行fn(0..n-1)
+// workers小于等于0时使用GOMAXPROCS；任一协程发生panic后停止分发新任务，并在调用方协程中重新panic
+func parallelRun(n, workers int, fn func(int)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	var (
+		wg        sync.WaitGroup
+		next      atomic.Int64
+		stopped   atomic.Bool
+		once      sync.Once
+		recovered any
+		panicked  bool
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() {
+						recovered = r
+						panicked = true
+					})
+					stopped.Store(true)
+				}
+			}()
+			for !stopped.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if panicked {
+		panic(recovered)
+	}
+}
+
+// ParallelMap 使用最多concurrency个协程并发地对集合中的每个元素应用回调函数，结果顺序与原集合一致
+func ParallelMap[T, U any](c *Collection[T], fn func(T) U, concurrency int) *Collection[U] {
+	mapped := make([]U, len(c.items))
+	parallelRun(len(c.items), concurrency, func(i int) {
+		mapped[i] = fn(c.items[i])
+	})
+	return &Collection[U]{items: mapped}
+}
+
+// ParallelFilter 使用最多concurrency个协程并发地过滤集合，结果顺序与原集合一致
+func (c *Collection[T]) ParallelFilter(fn func(T) bool, concurrency int) *Collection[T] {
+	keep := make([]bool, len(c.items))
+	parallelRun(len(c.items), concurrency, func(i int) {
+		keep[i] = fn(c.items[i])
+	})
+	filtered := make([]T, 0)
+	for i, item := range c.items {
+		if keep[i] {
+			filtered = append(filtered, item)
+		}
+	}
+	return &Collection[T]{items: filtered}
+}
+
+// ParallelEach 使用最多concurrency个协程并发地遍历集合中的每个元素，回调的执行顺序不确定
+func (c *Collection[T]) ParallelEach(fn func(T), concurrency int) *Collection[T] {
+	parallelRun(len(c.items), concurrency, func(i int) {
+		fn(c.items[i])
+	})
+	return c
+}
@@ -0,0 +1,81 @@
+package collection
+
+import (
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	c := New(1, 2, 3, 4, 5, 6, 7, 8)
+	mapped := ParallelMap(c, func(n int) int {
+		time.Sleep(time.Duration(8-n) * time.Millisecond)
+		return n * 10
+	}, 3)
+	if !slices.Equal(mapped.All(), []int{10, 20, 30, 40, 50, 60, 70, 80}) {
+		t.Errorf("Expected ordered result, got %v", mapped.All())
+	}
+}
+
+func TestParallelMapConcurrencyLimit(t *testing.T) {
+	var running, peak atomic.Int32
+	ParallelMap(New(1, 2, 3, 4, 5, 6, 7, 8, 9, 10), func(n int) int {
+		current := running.Add(1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return n
+	}, 2)
+	if peak.Load() > 2 {
+		t.Errorf("Expected at most 2 concurrent workers, got %d", peak.Load())
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	c := New(1, 2, 3, 4, 5, 6)
+	evens := c.ParallelFilter(func(n int) bool { return n%2 == 0 }, 4)
+	if !slices.Equal(evens.All(), []int{2, 4, 6}) {
+		t.Errorf("Expected [2 4 6], got %v", evens.All())
+	}
+}
+
+func TestParallelEach(t *testing.T) {
+	var sum atomic.Int64
+	c := New(1, 2, 3, 4, 5)
+	result := c.ParallelEach(func(n int) { sum.Add(int64(n)) }, 0)
+	if sum.Load() != 15 {
+		t.Errorf("Expected sum 15, got %d", sum.Load())
+	}
+	if result != c {
+		t.Error("Expected ParallelEach to return the original collection")
+	}
+}
+
+func TestParallelPanicPropagates(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r != "boom" {
+			t.Errorf("Expected panic value boom, got %v", r)
+		}
+	}()
+	ParallelMap(New(1, 2, 3), func(n int) int {
+		if n == 2 {
+			panic("boom")
+		}
+		return n
+	}, 2)
+	t.Error("Expected ParallelMap to panic")
+}
+
+func TestParallelEmpty(t *testing.T) {
+	mapped := ParallelMap(New[int](), func(n int) int { return n }, 4)
+	if !mapped.IsEmpty() {
+		t.Errorf("Expected empty collection, got %v", mapped.All())
+	}
+}
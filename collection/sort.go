@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// sortLevel 生成某一排序层级的比较函数，比较的是元素在items中的索引
+type sortLevel[T any] func(items []T, cached bool) func(i, j int) int
+
+// Sorter 多键排序构建器，由 SortBy 创建，通过 ThenBy / ThenByDesc 追加次级排序键
+// 排序是稳定的，所有键都相等的元素保持原有顺序
+type Sorter[T any] struct {
+	c      *Collection[T]
+	levels []sortLevel[T]
+	cached bool
+}
+
+// newSortLevel 根据键函数和方向创建排序层级
+func newSortLevel[T any, K cmp.Ordered](key func(T) K, desc bool) sortLevel[T] {
+	sign := 1
+	if desc {
+		sign = -1
+	}
+	return func(items []T, cached bool) func(i, j int) int {
+		if !cached {
+			return func(i, j int) int {
+				return sign * cmp.Compare(key(items[i]), key(items[j]))
+			}
+		}
+		keys := make([]K, len(items))
+		for i, item := range items {
+			keys[i] = key(item)
+		}
+		return func(i, j int) int {
+			return sign * cmp.Compare(keys[i], keys[j])
+		}
+	}
+}
+
+// SortBy 按给定的键升序排序
+func SortBy[T any, K cmp.Ordered](c *Collection[T], key func(T) K) *Sorter[T] {
+	return &Sorter[T]{c: c, levels: []sortLevel[T]{newSortLevel(key, false)}}
+}
+
+// SortByDesc 按给定的键降序排序
+func SortByDesc[T any, K cmp.Ordered](c *Collection[T], key func(T) K) *Sorter[T] {
+	return &Sorter[T]{c: c, levels: []sortLevel[T]{newSortLevel(key, true)}}
+}
+
+// ThenBy 追加升序的次级排序键
+func ThenBy[T any, K cmp.Ordered](s *Sorter[T], key func(T) K) *Sorter[T] {
+	return s.then(newSortLevel(key, false))
+}
+
+// ThenByDesc 追加降序的次级排序键
+func ThenByDesc[T any, K cmp.Ordered](s *Sorter[T], key func(T) K) *Sorter[T] {
+	return s.then(newSortLevel(key, true))
+}
+
+// then 返回追加了排序层级的新构建器
+func (s *Sorter[T]) then(level sortLevel[T]) *Sorter[T] {
+	levels := make([]sortLevel[T], len(s.levels), len(s.levels)+1)
+	copy(levels, s.levels)
+	return &Sorter[T]{c: s.c, levels: append(levels, level), cached: s.cached}
+}
+
+// CacheKeys 每个元素的每个排序键只计算一次（decorate-sort-undecorate），适用于代价较高的键函数
+func (s *Sorter[T]) CacheKeys() *Sorter[T] {
+	return &Sorter[T]{c: s.c, levels: s.levels, cached: true}
+}
+
+// Collect 执行排序并返回新集合
+func (s *Sorter[T]) Collect() *Collection[T] {
+	items := s.c.items
+	compares := make([]func(i, j int) int, len(s.levels))
+	for i, level := range s.levels {
+		compares[i] = level(items, s.cached)
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		for _, compare := range compares {
+			if r := compare(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	})
+
+	sorted := make([]T, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	return &Collection[T]{items: sorted}
+}
+
+// StableSort 对集合进行稳定排序，相等的元素保持原有顺序
+func StableSort[T any](c *Collection[T], less func(T, T) bool) *Collection[T] {
+	sorted := make([]T, len(c.items))
+	copy(sorted, c.items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+	return &Collection[T]{items: sorted}
+}
@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+type sortEmployee struct {
+	Dept string
+	Name string
+	Age  int
+}
+
+func TestSortByThenBy(t *testing.T) {
+	c := New(
+		sortEmployee{"eng", "Carol", 30},
+		sortEmployee{"ops", "Bob", 40},
+		sortEmployee{"eng", "Alice", 25},
+		sortEmployee{"ops", "Alice", 35},
+	)
+	sorted := ThenBy(SortBy(c, func(e sortEmployee) string { return e.Dept }),
+		func(e sortEmployee) string { return e.Name }).Collect()
+
+	names := Map(sorted, func(e sortEmployee) string { return e.Dept + "/" + e.Name }).All()
+	expected := []string{"eng/Alice", "eng/Carol", "ops/Alice", "ops/Bob"}
+	if !slices.Equal(names, expected) {
+		t.Errorf("Expected %v, got %v", expected, names)
+	}
+}
+
+func TestSortByDescThenByDesc(t *testing.T) {
+	c := New(
+		sortEmployee{"eng", "Carol", 30},
+		sortEmployee{"ops", "Bob", 40},
+		sortEmployee{"eng", "Alice", 25},
+	)
+	sorted := ThenByDesc(SortByDesc(c, func(e sortEmployee) string { return e.Dept }),
+		func(e sortEmployee) int { return e.Age }).Collect()
+
+	ages := Map(sorted, func(e sortEmployee) int { return e.Age }).All()
+	if !slices.Equal(ages, []int{40, 30, 25}) {
+		t.Errorf("Expected [40 30 25], got %v", ages)
+	}
+}
+
+func TestSortByIsStable(t *testing.T) {
+	items := make([]sortEmployee, 0)
+	for i := range 100 {
+		items = append(items, sortEmployee{Dept: []string{"a", "b"}[i%2], Age: i})
+	}
+	sorted := SortBy(FromSlice(items), func(e sortEmployee) string { return e.Dept }).Collect().All()
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Dept == sorted[i].Dept && sorted[i-1].Age > sorted[i].Age {
+			t.Fatalf("Expected stable order for equal keys, got %v before %v", sorted[i-1], sorted[i])
+		}
+	}
+}
+
+func TestSortByCacheKeys(t *testing.T) {
+	calls := 0
+	c := New(5, 3, 9, 1, 7, 2, 8)
+	sorted := SortBy(c, func(n int) int {
+		calls++
+		return n
+	}).CacheKeys().Collect()
+
+	if !slices.Equal(sorted.All(), []int{1, 2, 3, 5, 7, 8, 9}) {
+		t.Errorf("Expected sorted result, got %v", sorted.All())
+	}
+	if calls != c.Count() {
+		t.Errorf("Expected %d key calls, got %d", c.Count(), calls)
+	}
+	if !slices.Equal(c.All(), []int{5, 3, 9, 1, 7, 2, 8}) {
+		t.Errorf("Expected original collection unchanged, got %v", c.All())
+	}
+}
+
+func TestThenByDoesNotAliasLevels(t *testing.T) {
+	base := SortBy(New("bb", "a", "ab"), func(s string) int { return len(s) })
+	asc := ThenBy(base, func(s string) string { return s }).Collect().All()
+	desc := ThenByDesc(base, func(s string) string { return s }).Collect().All()
+	if !slices.Equal(asc, []string{"a", "ab", "bb"}) || !slices.Equal(desc, []string{"a", "bb", "ab"}) {
+		t.Errorf("Unexpected results %v %v", asc, desc)
+	}
+}
+
+func TestStableSort(t *testing.T) {
+	c := New("b1", "a1", "b2", "a2", "b3")
+	sorted := StableSort(c, func(x, y string) bool { return x[0] < y[0] })
+	if !slices.Equal(sorted.All(), []string{"a1", "a2", "b1", "b2", "b3"}) {
+		t.Errorf("Expected stable sort, got %v", sorted.All())
+	}
+}
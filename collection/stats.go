@@ -0,0 +1,195 @@
+package collection
+
+import (
+	"math"
+	"slices"
+)
+
+// PercentileMethod 百分位数在两个数据点之间的插值方式
+type PercentileMethod int
+
+const (
+	// PercentileLinear 线性插值（与 NumPy 默认方式和 Excel PERCENTILE.INC 一致）
+	PercentileLinear PercentileMethod = iota
+	// PercentileLower 取较小的数据点
+	PercentileLower
+	// PercentileHigher 取较大的数据点
+	PercentileHigher
+	// PercentileNearest 取最近的数据点，距离相同时取索引为偶数的数据点
+	PercentileNearest
+	// PercentileMidpoint 取两个数据点的中点
+	PercentileMidpoint
+)
+
+// Stats 描述性统计摘要，StdDev 为样本标准差
+type Stats struct {
+	Count  int     `json:"count"`
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std"`
+	Min    float64 `json:"min"`
+	P25    float64 `json:"p25"`
+	Median float64 `json:"median"`
+	P75    float64 `json:"p75"`
+	Max    float64 `json:"max"`
+}
+
+// welford 使用 Welford 算法单次遍历计算均值和方差
+type welford struct {
+	count int
+	mean  float64
+	m2    float64
+}
+
+// add 累加一个值
+func (w *welford) add(value float64) {
+	w.count++
+	delta := value - w.mean
+	w.mean += delta / float64(w.count)
+	w.m2 += delta * (value - w.mean)
+}
+
+// welfordOf 对集合元素执行 Welford 算法
+func welfordOf[T any](c *Collection[T], fn func(T) float64) welford {
+	var w welford
+	for _, item := range c.items {
+		w.add(fn(item))
+	}
+	return w
+}
+
+// sortedValues 返回升序排列的键值
+func sortedValues[T any](c *Collection[T], fn func(T) float64) []float64 {
+	values := make([]float64, len(c.items))
+	for i, item := range c.items {
+		values[i] = fn(item)
+	}
+	slices.Sort(values)
+	return values
+}
+
+// percentileOfSorted 在已排序的数据上计算百分位数，p取值范围为[0, 100]
+func percentileOfSorted(values []float64, p float64, method PercentileMethod) float64 {
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+
+	switch method {
+	case PercentileLower:
+		return values[lower]
+	case PercentileHigher:
+		return values[upper]
+	case PercentileNearest:
+		if fraction == 0.5 {
+			if lower%2 == 0 {
+				return values[lower]
+			}
+			return values[upper]
+		}
+		return values[int(math.Round(rank))]
+	case PercentileMidpoint:
+		return (values[lower] + values[upper]) / 2
+	default:
+		return values[lower] + (values[upper]-values[lower])*fraction
+	}
+}
+
+// Median 计算集合元素的中位数
+func Median[T any](c *Collection[T], fn func(T) float64) (float64, bool) {
+	return Percentile(c, fn, 50, PercentileLinear)
+}
+
+// Mode 返回集合中出现次数最多的所有值，按升序排列
+func Mode[T any](c *Collection[T], fn func(T) float64) []float64 {
+	counts := make(map[float64]int)
+	best := 0
+	for _, item := range c.items {
+		value := fn(item)
+		counts[value]++
+		best = max(best, counts[value])
+	}
+	modes := make([]float64, 0)
+	for value, count := range counts {
+		if count == best {
+			modes = append(modes, value)
+		}
+	}
+	slices.Sort(modes)
+	return modes
+}
+
+// Percentile 使用指定的插值方式计算第p百分位数，p取值范围为[0, 100]
+func Percentile[T any](c *Collection[T], fn func(T) float64, p float64, method PercentileMethod) (float64, bool) {
+	if len(c.items) == 0 || p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, false
+	}
+	return percentileOfSorted(sortedValues(c, fn), p, method), true
+}
+
+// Quantiles 返回将数据分成n个等份的n-1个分割点（线性插值）
+func Quantiles[T any](c *Collection[T], fn func(T) float64, n int) []float64 {
+	if len(c.items) == 0 || n < 2 {
+		return nil
+	}
+	values := sortedValues(c, fn)
+	cuts := make([]float64, n-1)
+	for i := range cuts {
+		cuts[i] = percentileOfSorted(values, float64(i+1)*100/float64(n), PercentileLinear)
+	}
+	return cuts
+}
+
+// Variance 计算总体方差
+func Variance[T any](c *Collection[T], fn func(T) float64) (float64, bool) {
+	w := welfordOf(c, fn)
+	if w.count == 0 {
+		return 0, false
+	}
+	return w.m2 / float64(w.count), true
+}
+
+// SampleVariance 计算样本方差，至少需要两个元素
+func SampleVariance[T any](c *Collection[T], fn func(T) float64) (float64, bool) {
+	w := welfordOf(c, fn)
+	if w.count < 2 {
+		return 0, false
+	}
+	return w.m2 / float64(w.count-1), true
+}
+
+// StdDev 计算总体标准差
+func StdDev[T any](c *Collection[T], fn func(T) float64) (float64, bool) {
+	variance, ok := Variance(c, fn)
+	return math.Sqrt(variance), ok
+}
+
+// SampleStdDev 计算样本标准差，至少需要两个元素
+func SampleStdDev[T any](c *Collection[T], fn func(T) float64) (float64, bool) {
+	variance, ok := SampleVariance(c, fn)
+	return math.Sqrt(variance), ok
+}
+
+// Describe 计算描述性统计摘要，元素少于两个时 StdDev 为0
+func Describe[T any](c *Collection[T], fn func(T) float64) (Stats, bool) {
+	if len(c.items) == 0 {
+		return Stats{}, false
+	}
+	values := sortedValues(c, fn)
+	var w welford
+	for _, value := range values {
+		w.add(value)
+	}
+	stats := Stats{
+		Count:  w.count,
+		Mean:   w.mean,
+		Min:    values[0],
+		P25:    percentileOfSorted(values, 25, PercentileLinear),
+		Median: percentileOfSorted(values, 50, PercentileLinear),
+		P75:    percentileOfSorted(values, 75, PercentileLinear),
+		Max:    values[len(values)-1],
+	}
+	if w.count > 1 {
+		stats.StdDev = math.Sqrt(w.m2 / float64(w.count-1))
+	}
+	return stats, true
+}
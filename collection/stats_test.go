@@ -0,0 +1,114 @@
+package collection
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func identity(f float64) float64 { return f }
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestMedian(t *testing.T) {
+	if median, ok := Median(New(3.0, 1, 2), identity); !ok || median != 2 {
+		t.Errorf("Expected 2, got %v", median)
+	}
+	if median, ok := Median(New(4.0, 1, 3, 2), identity); !ok || median != 2.5 {
+		t.Errorf("Expected 2.5, got %v", median)
+	}
+	if _, ok := Median(New[float64](), identity); ok {
+		t.Error("Expected no median for empty collection")
+	}
+}
+
+func TestMode(t *testing.T) {
+	modes := Mode(New(1.0, 3, 3, 2, 1, 4), identity)
+	if !slices.Equal(modes, []float64{1, 3}) {
+		t.Errorf("Expected [1 3], got %v", modes)
+	}
+	if len(Mode(New[float64](), identity)) != 0 {
+		t.Error("Expected no modes for empty collection")
+	}
+}
+
+func TestPercentileMethods(t *testing.T) {
+	c := New(1.0, 2, 3, 4)
+	cases := []struct {
+		method   PercentileMethod
+		expected float64
+	}{
+		{PercentileLinear, 1.6},
+		{PercentileLower, 1},
+		{PercentileHigher, 2},
+		{PercentileNearest, 2},
+		{PercentileMidpoint, 1.5},
+	}
+	for _, tc := range cases {
+		value, ok := Percentile(c, identity, 20, tc.method)
+		if !ok || !almostEqual(value, tc.expected) {
+			t.Errorf("Method %d: expected %v, got %v", tc.method, tc.expected, value)
+		}
+	}
+
+	if value, _ := Percentile(c, identity, 100, PercentileLinear); value != 4 {
+		t.Errorf("Expected p100 to be max, got %v", value)
+	}
+	if _, ok := Percentile(c, identity, 101, PercentileLinear); ok {
+		t.Error("Expected out-of-range percentile to fail")
+	}
+}
+
+func TestQuantiles(t *testing.T) {
+	quartiles := Quantiles(New(1.0, 2, 3, 4, 5), identity, 4)
+	if !slices.Equal(quartiles, []float64{2, 3, 4}) {
+		t.Errorf("Expected [2 3 4], got %v", quartiles)
+	}
+	if Quantiles(New(1.0), identity, 1) != nil {
+		t.Error("Expected nil for n < 2")
+	}
+}
+
+func TestVarianceStdDev(t *testing.T) {
+	c := New(2.0, 4, 4, 4, 5, 5, 7, 9)
+	if variance, _ := Variance(c, identity); !almostEqual(variance, 4) {
+		t.Errorf("Expected population variance 4, got %v", variance)
+	}
+	if std, _ := StdDev(c, identity); !almostEqual(std, 2) {
+		t.Errorf("Expected population std 2, got %v", std)
+	}
+	if variance, _ := SampleVariance(c, identity); !almostEqual(variance, 32.0/7) {
+		t.Errorf("Expected sample variance 32/7, got %v", variance)
+	}
+	if std, _ := SampleStdDev(c, identity); !almostEqual(std, math.Sqrt(32.0/7)) {
+		t.Errorf("Expected sample std sqrt(32/7), got %v", std)
+	}
+	if _, ok := SampleVariance(New(1.0), identity); ok {
+		t.Error("Expected sample variance to require two elements")
+	}
+}
+
+func TestVarianceIsNumericallyStable(t *testing.T) {
+	c := New(1e9+4, 1e9+7, 1e9+13, 1e9+16)
+	if variance, _ := SampleVariance(c, identity); !almostEqual(variance, 30) {
+		t.Errorf("Expected sample variance 30, got %v", variance)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	type Score struct{ Value float64 }
+	c := New(Score{4}, Score{1}, Score{3}, Score{2}, Score{5})
+	stats, ok := Describe(c, func(s Score) float64 { return s.Value })
+	if !ok {
+		t.Fatal("Describe failed")
+	}
+	expected := Stats{Count: 5, Mean: 3, StdDev: math.Sqrt(2.5), Min: 1, P25: 2, Median: 3, P75: 4, Max: 5}
+	if stats != expected {
+		t.Errorf("Expected %+v, got %+v", expected, stats)
+	}
+	if _, ok := Describe(New[Score](), func(s Score) float64 { return s.Value }); ok {
+		t.Error("Expected Describe to fail on empty collection")
+	}
+}
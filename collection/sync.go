@@ -0,0 +1,241 @@
+package collection
+
+import (
+	"slices"
+	"sync"
+)
+
+// SyncCollection 并发安全的集合，使用读写锁保护内部集合
+// 读操作返回一致的快照，回调函数在快照上执行，不持有锁（原子复合操作除外）
+// 只包装了 Collection 的方法；Map、Reduce、Sort 等泛型函数请在 Snapshot() 的结果上调用
+type SyncCollection[T any] struct {
+	mu sync.RWMutex
+	c  *Collection[T]
+}
+
+// NewSync 创建一个新的并发安全集合，会复制传入的元素，调用方之后修改原切片不会影响集合
+func NewSync[T any](items ...T) *SyncCollection[T] {
+	return &SyncCollection[T]{c: New(slices.Clone(items)...)}
+}
+
+// Sync 将集合的副本包装为并发安全集合
+func (c *Collection[T]) Sync() *SyncCollection[T] {
+	return &SyncCollection[T]{c: c.Clone()}
+}
+
+// read 在读锁下执行fn
+func (s *SyncCollection[T]) read(fn func(c *Collection[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.c)
+}
+
+// write 在写锁下执行fn
+func (s *SyncCollection[T]) write(fn func(c *Collection[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.c)
+}
+
+// Snapshot 返回当前内容的一致性快照
+func (s *SyncCollection[T]) Snapshot() *Collection[T] {
+	var snapshot *Collection[T]
+	s.read(func(c *Collection[T]) {
+		snapshot = c.Clone()
+	})
+	return snapshot
+}
+
+// All 返回所有元素的副本
+func (s *SyncCollection[T]) All() []T {
+	return s.Snapshot().items
+}
+
+// Count 返回集合中的项数
+func (s *SyncCollection[T]) Count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Count()
+}
+
+// IsEmpty 检查集合是否为空
+func (s *SyncCollection[T]) IsEmpty() bool {
+	return s.Count() == 0
+}
+
+// IsNotEmpty 检查集合是否不为空
+func (s *SyncCollection[T]) IsNotEmpty() bool {
+	return s.Count() > 0
+}
+
+// First 获取集合的第一个元素
+func (s *SyncCollection[T]) First() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.First()
+}
+
+// Last 获取集合的最后一个元素
+func (s *SyncCollection[T]) Last() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Last()
+}
+
+// Get 根据索引获取元素
+func (s *SyncCollection[T]) Get(index int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c.Get(index)
+}
+
+// Push 向集合末尾添加元素
+func (s *SyncCollection[T]) Push(items ...T) *SyncCollection[T] {
+	s.write(func(c *Collection[T]) {
+		c.Push(items...)
+	})
+	return s
+}
+
+// Pop 移除并返回集合的最后一个元素
+func (s *SyncCollection[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Pop()
+}
+
+// Shift 移除并返回集合的第一个元素
+func (s *SyncCollection[T]) Shift() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Shift()
+}
+
+// Prepend 向集合开头添加元素，会复制传入的元素，避免调用方的切片成为集合的底层数组
+func (s *SyncCollection[T]) Prepend(items ...T) *SyncCollection[T] {
+	s.write(func(c *Collection[T]) {
+		c.Prepend(slices.Clone(items)...)
+	})
+	return s
+}
+
+// Filter 在快照上过滤集合，返回普通集合
+func (s *SyncCollection[T]) Filter(fn func(T) bool) *Collection[T] {
+	return s.Snapshot().Filter(fn)
+}
+
+// Reject 在快照上排除集合中的元素，返回普通集合
+func (s *SyncCollection[T]) Reject(fn func(T) bool) *Collection[T] {
+	return s.Snapshot().Reject(fn)
+}
+
+// Each 在快照上遍历集合中的每个元素
+func (s *SyncCollection[T]) Each(fn func(T)) *SyncCollection[T] {
+	s.Snapshot().Each(fn)
+	return s
+}
+
+// EachWithIndex 在快照上遍历集合中的每个元素（带索引）
+func (s *SyncCollection[T]) EachWithIndex(fn func(int, T)) *SyncCollection[T] {
+	s.Snapshot().EachWithIndex(fn)
+	return s
+}
+
+// Slice 在快照上截取集合的一部分，返回普通集合
+func (s *SyncCollection[T]) Slice(start, end int) *Collection[T] {
+	return s.Snapshot().Slice(start, end)
+}
+
+// Take 在快照上获取前n个元素，返回普通集合
+func (s *SyncCollection[T]) Take(n int) *Collection[T] {
+	return s.Snapshot().Take(n)
+}
+
+// Skip 在快照上跳过前n个元素，返回普通集合
+func (s *SyncCollection[T]) Skip(n int) *Collection[T] {
+	return s.Snapshot().Skip(n)
+}
+
+// Reverse 在快照上反转集合，返回普通集合
+func (s *SyncCollection[T]) Reverse() *Collection[T] {
+	return s.Snapshot().Reverse()
+}
+
+// Partition 在快照上将集合分为满足条件和不满足条件的两部分
+func (s *SyncCollection[T]) Partition(fn func(T) bool) (*Collection[T], *Collection[T]) {
+	return s.Snapshot().Partition(fn)
+}
+
+// ContainsFunc 在快照上检查集合是否包含满足条件的元素
+func (s *SyncCollection[T]) ContainsFunc(fn func(T) bool) bool {
+	return s.Snapshot().ContainsFunc(fn)
+}
+
+// Every 在快照上检查集合中的所有元素是否都满足条件
+func (s *SyncCollection[T]) Every(fn func(T) bool) bool {
+	return s.Snapshot().Every(fn)
+}
+
+// Some 在快照上检查集合中是否至少有一个元素满足条件
+func (s *SyncCollection[T]) Some(fn func(T) bool) bool {
+	return s.Snapshot().Some(fn)
+}
+
+// PushIfAbsentFunc 当没有元素满足条件时原子地添加元素，返回是否已添加
+// 回调函数在写锁内执行，不能再调用该集合的方法
+func (s *SyncCollection[T]) PushIfAbsentFunc(item T, exists func(T) bool) bool {
+	added := false
+	s.write(func(c *Collection[T]) {
+		if !c.ContainsFunc(exists) {
+			c.Push(item)
+			added = true
+		}
+	})
+	return added
+}
+
+// PopWhere 原子地移除并返回第一个满足条件的元素
+// 回调函数在写锁内执行，不能再调用该集合的方法
+func (s *SyncCollection[T]) PopWhere(fn func(T) bool) (T, bool) {
+	var (
+		found T
+		ok    bool
+	)
+	s.write(func(c *Collection[T]) {
+		for i, item := range c.items {
+			if fn(item) {
+				found, ok = item, true
+				c.items = append(c.items[:i:i], c.items[i+1:]...)
+				return
+			}
+		}
+	})
+	return found, ok
+}
+
+// Update 原子地用回调函数的返回值替换集合内容，返回nil时清空集合
+// 回调函数在写锁内执行，不能再调用该集合的方法
+func (s *SyncCollection[T]) Update(fn func(*Collection[T]) *Collection[T]) *SyncCollection[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if updated := fn(s.c); updated != nil {
+		s.c = updated
+	} else {
+		s.c = New[T]()
+	}
+	return s
+}
+
+// With 在持有写锁时执行回调函数，回调函数可以直接修改集合
+// 回调函数不能保留集合的引用，也不能再调用该集合的方法
+func (s *SyncCollection[T]) With(fn func(*Collection[T])) *SyncCollection[T] {
+	s.write(fn)
+	return s
+}
+
+// PushIfAbsent 当集合中不包含给定元素时原子地添加，返回是否已添加
+func PushIfAbsent[T comparable](s *SyncCollection[T], item T) bool {
+	return s.PushIfAbsentFunc(item, func(existing T) bool {
+		return existing == item
+	})
+}
@@ -0,0 +1,184 @@
+package collection
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncCollectionConcurrentPush(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				s.Push(w*100 + i)
+				_ = s.Filter(func(n int) bool { return n%2 == 0 }).Count()
+			}
+		}()
+	}
+	wg.Wait()
+	if s.Count() != 800 {
+		t.Errorf("Expected 800 items, got %d", s.Count())
+	}
+}
+
+func TestSyncCollectionSnapshot(t *testing.T) {
+	s := NewSync(1, 2, 3)
+	snapshot := s.Snapshot()
+	s.Push(4)
+	if snapshot.Count() != 3 || s.Count() != 4 {
+		t.Errorf("Expected snapshot to be isolated, got %v and %v", snapshot.All(), s.All())
+	}
+
+	all := s.All()
+	all[0] = 100
+	if first, _ := s.First(); first != 1 {
+		t.Errorf("Expected All to return a copy, got first %d", first)
+	}
+}
+
+func TestSyncCollectionFromCollection(t *testing.T) {
+	c := New(1, 2)
+	s := c.Sync()
+	c.Push(3)
+	if s.Count() != 2 {
+		t.Errorf("Expected wrapper to own a copy, got %d", s.Count())
+	}
+}
+
+func TestNewSyncCopiesItems(t *testing.T) {
+	items := []int{1, 2, 3}
+	s := NewSync(items...)
+	items[0] = 100
+	if first, _ := s.First(); first != 1 {
+		t.Errorf("Expected NewSync to own a copy, got first %d", first)
+	}
+}
+
+func TestSyncPrependCopiesItems(t *testing.T) {
+	s := NewSync(2, 3)
+	buf := make([]int, 1, 10)
+	buf[0] = 1
+	s.Prepend(buf...)
+	buf[:3][1] = 99
+	if got := s.All(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected Prepend to own a copy, got %v", got)
+	}
+}
+
+func TestSyncCollectionSnapshotHelpers(t *testing.T) {
+	s := NewSync(1, 2, 3, 4, 5)
+	if got := s.Take(2).All(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Expected Take [1 2], got %v", got)
+	}
+	if got := s.Skip(3).All(); !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("Expected Skip [4 5], got %v", got)
+	}
+	if got := s.Slice(1, 3).All(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Expected Slice [2 3], got %v", got)
+	}
+	if got := s.Reverse().All(); !slices.Equal(got, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("Expected Reverse [5 4 3 2 1], got %v", got)
+	}
+	even, odd := s.Partition(func(n int) bool { return n%2 == 0 })
+	if !slices.Equal(even.All(), []int{2, 4}) || !slices.Equal(odd.All(), []int{1, 3, 5}) {
+		t.Errorf("Expected Partition [2 4] [1 3 5], got %v %v", even.All(), odd.All())
+	}
+
+	taken := s.Take(2)
+	taken.items[0] = 100
+	if first, _ := s.First(); first != 1 {
+		t.Errorf("Expected Take to work on a snapshot, got first %d", first)
+	}
+}
+
+func TestPushIfAbsent(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	added := make(chan bool, 16)
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			added <- PushIfAbsent(s, 42)
+		}()
+	}
+	wg.Wait()
+	close(added)
+
+	count := 0
+	for ok := range added {
+		if ok {
+			count++
+		}
+	}
+	if count != 1 || s.Count() != 1 {
+		t.Errorf("Expected exactly one push, got %d pushes and %d items", count, s.Count())
+	}
+}
+
+func TestPopWhere(t *testing.T) {
+	s := NewSync(1, 2, 3, 4)
+	item, ok := s.PopWhere(func(n int) bool { return n%2 == 0 })
+	if !ok || item != 2 || !slices.Equal(s.All(), []int{1, 3, 4}) {
+		t.Errorf("Expected to pop 2, got %d %v", item, s.All())
+	}
+	_, ok = s.PopWhere(func(n int) bool { return n > 10 })
+	if ok {
+		t.Error("Expected no match")
+	}
+}
+
+func TestSyncCollectionUpdateAndWith(t *testing.T) {
+	s := NewSync(3, 1, 2)
+	s.Update(func(c *Collection[int]) *Collection[int] {
+		return Sort(c, func(a, b int) bool { return a < b })
+	})
+	if !slices.Equal(s.All(), []int{1, 2, 3}) {
+		t.Errorf("Expected sorted contents, got %v", s.All())
+	}
+
+	s.With(func(c *Collection[int]) {
+		c.Pop()
+		c.Prepend(0)
+	})
+	if !slices.Equal(s.All(), []int{0, 1, 2}) {
+		t.Errorf("Expected [0 1 2], got %v", s.All())
+	}
+
+	s.Update(func(c *Collection[int]) *Collection[int] { return nil })
+	if !s.IsEmpty() {
+		t.Errorf("Expected empty collection, got %v", s.All())
+	}
+}
+
+func TestSyncCollectionConcurrentMixed(t *testing.T) {
+	s := NewSync[int]()
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				s.Push(w*1000 + i)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 200 {
+				s.PopWhere(func(n int) bool { return n%3 == 0 })
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 200 {
+				s.Each(func(int) {})
+				s.Update(func(c *Collection[int]) *Collection[int] { return c })
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,446 @@
+package collection
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// TableFormat 表格的输出格式
+type TableFormat int
+
+const (
+	// TableASCII 使用 +-| 绘制边框的文本表格
+	TableASCII TableFormat = iota
+	// TableMarkdown GitHub 风格的 Markdown 表格
+	TableMarkdown
+	// TableTSV 制表符分隔的文本，不对齐
+	TableTSV
+	// TableHTML HTML <table>，单元格内容会被转义
+	TableHTML
+)
+
+// TableOptions 表格渲染选项
+type TableOptions struct {
+	// Format 输出格式，默认为 TableASCII
+	Format TableFormat
+	// Columns 列及其顺序，可以使用表头、Go字段名、json标签名或 map 的键，为空时使用所有列
+	Columns []string
+	// MaxWidth 单元格的最大显示宽度，超出部分以"…"截断，小于等于0时不限制
+	MaxWidth int
+}
+
+// tableAlign 列的对齐方式
+type tableAlign int
+
+const (
+	alignLeft tableAlign = iota
+	alignRight
+	alignCenter
+)
+
+// tableColumn 表格的一列，cell 从已解引用的元素中取出单元格的值
+type tableColumn struct {
+	header string
+	names  []string
+	align  tableAlign
+	order  int
+	cell   func(item reflect.Value) (reflect.Value, bool)
+}
+
+// tableColumnCache 缓存每个结构体类型的表格列
+var tableColumnCache sync.Map // map[reflect.Type][]*tableColumn
+
+// tableColumnsOf 返回结构体类型的表格列
+// 表头依次取 table 标签、json 标签和Go字段名，table 标签还支持 order=N（按N升序排列）和 align=left|right|center 选项
+// 标签为"-"的字段被忽略，数值列默认右对齐
+func tableColumnsOf(t reflect.Type) []*tableColumn {
+	if cached, ok := tableColumnCache.Load(t); ok {
+		return cached.([]*tableColumn)
+	}
+
+	columns := make([]*tableColumn, 0)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || (f.Anonymous && indirectType(f.Type).Kind() == reflect.Struct) {
+			continue
+		}
+		column := &tableColumn{header: f.Name, names: []string{f.Name}}
+		if isNumeric(kindOf(indirectType(f.Type))) {
+			column.align = alignRight
+		}
+		if name := jsonFieldName(f); name != "" {
+			column.header = name
+			column.names = append(column.names, name)
+		}
+		if tag, ok := f.Tag.Lookup("table"); ok {
+			if tag == "-" {
+				continue
+			}
+			name, options, _ := strings.Cut(tag, ",")
+			if name != "" {
+				column.header = name
+				column.names = append(column.names, name)
+			}
+			applyTableOptions(column, options)
+		} else if f.Tag.Get("json") == "-" {
+			continue
+		}
+		index := f.Index
+		column.cell = func(item reflect.Value) (reflect.Value, bool) {
+			v, err := item.FieldByIndexErr(index)
+			return v, err == nil
+		}
+		columns = append(columns, column)
+	}
+	slices.SortStableFunc(columns, func(a, b *tableColumn) int {
+		return a.order - b.order
+	})
+	actual, _ := tableColumnCache.LoadOrStore(t, columns)
+	return actual.([]*tableColumn)
+}
+
+// applyTableOptions 解析 table 标签中的选项
+func applyTableOptions(column *tableColumn, options string) {
+	for _, option := range strings.Split(options, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+		switch key {
+		case "order":
+			column.order, _ = strconv.Atoi(value)
+		case "align":
+			switch value {
+			case "left":
+				column.align = alignLeft
+			case "right":
+				column.align = alignRight
+			case "center":
+				column.align = alignCenter
+			}
+		}
+	}
+}
+
+// tableMapColumns 返回 map 集合的表格列，键按字符串顺序排列
+func tableMapColumns[T any](c *Collection[T]) []*tableColumn {
+	keys := make(map[string]reflect.Value)
+	for _, item := range c.items {
+		v, ok := indirect(reflect.ValueOf(&item).Elem())
+		if !ok {
+			continue
+		}
+		for _, key := range v.MapKeys() {
+			keys[fmt.Sprint(key.Interface())] = key
+		}
+	}
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	columns := make([]*tableColumn, len(names))
+	for i, name := range names {
+		key := keys[name]
+		columns[i] = &tableColumn{header: name, names: []string{name}, cell: func(item reflect.Value) (reflect.Value, bool) {
+			v := item.MapIndex(key)
+			return v, v.IsValid()
+		}}
+	}
+	return columns
+}
+
+// tableColumnsFor 返回集合的表格列：结构体按字段，map 按键，其他类型只有一列 value
+func tableColumnsFor[T any](c *Collection[T], names []string) ([]*tableColumn, error) {
+	t := indirectType(reflect.TypeFor[T]())
+	var columns []*tableColumn
+	switch t.Kind() {
+	case reflect.Struct:
+		columns = tableColumnsOf(t)
+	case reflect.Map:
+		columns = tableMapColumns(c)
+	default:
+		columns = []*tableColumn{{header: "value", names: []string{"value"}, cell: func(item reflect.Value) (reflect.Value, bool) {
+			return item, true
+		}}}
+		if isNumeric(kindOf(t)) {
+			columns[0].align = alignRight
+		}
+	}
+	if len(names) == 0 {
+		return columns, nil
+	}
+
+	selected := make([]*tableColumn, len(names))
+	for i, name := range names {
+		for _, column := range columns {
+			if slices.Contains(column.names, name) {
+				selected[i] = column
+				break
+			}
+		}
+		if selected[i] == nil && t.Kind() == reflect.Map {
+			selected[i] = &tableColumn{header: name, cell: func(reflect.Value) (reflect.Value, bool) {
+				return reflect.Value{}, false
+			}}
+		}
+		if selected[i] == nil {
+			return nil, &FieldError{Type: t.String(), Field: name, Err: ErrUnknownField, Detail: "no table column with this name"}
+		}
+	}
+	return selected, nil
+}
+
+// RenderTable 将集合渲染为表格写入w，元素可以是结构体、结构体指针或 map，其他类型渲染为单列 value
+// 对齐时按显示宽度计算，中日韩等宽字符占两列；nil 值渲染为空单元格
+func (c *Collection[T]) RenderTable(w io.Writer, opts TableOptions) error {
+	columns, err := tableColumnsFor(c, opts.Columns)
+	if err != nil {
+		return err
+	}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.header
+	}
+	rows := make([][]string, len(c.items))
+	for r, item := range c.items {
+		rows[r] = make([]string, len(columns))
+		v, ok := indirect(reflect.ValueOf(&item).Elem())
+		if !ok {
+			continue
+		}
+		for i, column := range columns {
+			if cell, ok := column.cell(v); ok {
+				rows[r][i] = formatTableCell(cell)
+			}
+		}
+	}
+
+	writer := bufio.NewWriter(w)
+	switch opts.Format {
+	case TableMarkdown:
+		renderMarkdownTable(writer, columns, headers, rows, opts.MaxWidth)
+	case TableTSV:
+		renderTSVTable(writer, headers, rows)
+	case TableHTML:
+		renderHTMLTable(writer, columns, headers, rows)
+	default:
+		renderASCIITable(writer, columns, headers, rows, opts.MaxWidth)
+	}
+	return writer.Flush()
+}
+
+// formatTableCell 将单元格的值格式化为字符串
+func formatTableCell(v reflect.Value) string {
+	v, ok := indirect(v)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// wideRanges 显示宽度为2的字符范围
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // 韩文字母
+	{0x2E80, 0x303E},   // 中日韩部首、标点
+	{0x3041, 0x33FF},   // 假名、注音、中日韩符号
+	{0x3400, 0x4DBF},   // 中日韩统一表意文字扩展A
+	{0x4E00, 0x9FFF},   // 中日韩统一表意文字
+	{0xA000, 0xA4CF},   // 彝文
+	{0xAC00, 0xD7A3},   // 韩文音节
+	{0xF900, 0xFAFF},   // 中日韩兼容表意文字
+	{0xFE30, 0xFE4F},   // 中日韩兼容形式
+	{0xFF00, 0xFF60},   // 全角字符
+	{0xFFE0, 0xFFE6},   // 全角符号
+	{0x1F300, 0x1F64F}, // emoji
+	{0x1F900, 0x1F9FF}, // emoji
+	{0x20000, 0x3FFFD}, // 中日韩统一表意文字扩展B及以后
+}
+
+// runeWidth 返回字符的显示宽度：中日韩文字、全角字符和大部分 emoji 为2，组合字符和控制字符为0，其他为1
+func runeWidth(r rune) int {
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) || unicode.IsControl(r) {
+		return 0
+	}
+	for _, wide := range wideRanges {
+		if r >= wide[0] && r <= wide[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// stringWidth 返回字符串的显示宽度
+func stringWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// truncateWidth 将字符串截断到最大显示宽度，被截断时以"…"结尾
+func truncateWidth(s string, maxWidth int) string {
+	if maxWidth <= 0 || stringWidth(s) <= maxWidth {
+		return s
+	}
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		if width+runeWidth(r) > maxWidth-1 {
+			break
+		}
+		b.WriteRune(r)
+		width += runeWidth(r)
+	}
+	b.WriteString("…")
+	return b.String()
+}
+
+// padCell 按显示宽度填充单元格
+func padCell(s string, width int, align tableAlign) string {
+	gap := width - stringWidth(s)
+	if gap <= 0 {
+		return s
+	}
+	switch align {
+	case alignRight:
+		return strings.Repeat(" ", gap) + s
+	case alignCenter:
+		return strings.Repeat(" ", gap/2) + s + strings.Repeat(" ", gap-gap/2)
+	default:
+		return s + strings.Repeat(" ", gap)
+	}
+}
+
+// prepareTextCells 将单元格中的换行和制表符替换为空格并截断（表头不截断），返回处理后的单元格及每列的宽度
+func prepareTextCells(headers []string, rows [][]string, maxWidth int, escape func(string) string) ([]string, [][]string, []int) {
+	clean := func(s string, maxWidth int) string {
+		s = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\t", " ").Replace(s)
+		return escape(truncateWidth(s, maxWidth))
+	}
+	widths := make([]int, len(headers))
+	cleanHeaders := make([]string, len(headers))
+	for i, header := range headers {
+		cleanHeaders[i] = clean(header, 0)
+		widths[i] = stringWidth(cleanHeaders[i])
+	}
+	cleanRows := make([][]string, len(rows))
+	for r, row := range rows {
+		cleanRows[r] = make([]string, len(row))
+		for i, cell := range row {
+			cleanRows[r][i] = clean(cell, maxWidth)
+			widths[i] = max(widths[i], stringWidth(cleanRows[r][i]))
+		}
+	}
+	return cleanHeaders, cleanRows, widths
+}
+
+// renderASCIITable 渲染带边框的文本表格
+func renderASCIITable(w *bufio.Writer, columns []*tableColumn, headers []string, rows [][]string, maxWidth int) {
+	headers, rows, widths := prepareTextCells(headers, rows, maxWidth, func(s string) string { return s })
+	border := func() {
+		for _, width := range widths {
+			w.WriteString("+" + strings.Repeat("-", width+2))
+		}
+		w.WriteString("+\n")
+	}
+	line := func(cells []string, header bool) {
+		for i, cell := range cells {
+			align := columns[i].align
+			if header {
+				align = alignLeft
+			}
+			w.WriteString("| " + padCell(cell, widths[i], align) + " ")
+		}
+		w.WriteString("|\n")
+	}
+
+	border()
+	line(headers, true)
+	border()
+	for _, row := range rows {
+		line(row, false)
+	}
+	if len(rows) > 0 {
+		border()
+	}
+}
+
+// renderMarkdownTable 渲染 Markdown 表格，单元格中的"|"会被转义
+func renderMarkdownTable(w *bufio.Writer, columns []*tableColumn, headers []string, rows [][]string, maxWidth int) {
+	escape := func(s string) string { return strings.ReplaceAll(s, "|", `\|`) }
+	headers, rows, widths := prepareTextCells(headers, rows, maxWidth, escape)
+	for i := range widths {
+		widths[i] = max(widths[i], 3)
+	}
+	line := func(cells []string, header bool) {
+		for i, cell := range cells {
+			align := columns[i].align
+			if header {
+				align = alignLeft
+			}
+			w.WriteString("| " + padCell(cell, widths[i], align) + " ")
+		}
+		w.WriteString("|\n")
+	}
+
+	line(headers, true)
+	for i, width := range widths {
+		switch columns[i].align {
+		case alignRight:
+			w.WriteString("| " + strings.Repeat("-", width-1) + ": ")
+		case alignCenter:
+			w.WriteString("| :" + strings.Repeat("-", width-2) + ": ")
+		default:
+			w.WriteString("| " + strings.Repeat("-", width) + " ")
+		}
+	}
+	w.WriteString("|\n")
+	for _, row := range rows {
+		line(row, false)
+	}
+}
+
+// renderTSVTable 渲染制表符分隔的文本
+func renderTSVTable(w *bufio.Writer, headers []string, rows [][]string) {
+	headers, rows, _ = prepareTextCells(headers, rows, 0, func(s string) string { return s })
+	w.WriteString(strings.Join(headers, "\t") + "\n")
+	for _, row := range rows {
+		w.WriteString(strings.Join(row, "\t") + "\n")
+	}
+}
+
+// renderHTMLTable 渲染 HTML 表格
+func renderHTMLTable(w *bufio.Writer, columns []*tableColumn, headers []string, rows [][]string) {
+	style := func(i int) string {
+		switch columns[i].align {
+		case alignRight:
+			return ` style="text-align: right"`
+		case alignCenter:
+			return ` style="text-align: center"`
+		default:
+			return ""
+		}
+	}
+
+	w.WriteString("<table>\n  <thead>\n    <tr>")
+	for i, header := range headers {
+		w.WriteString("<th" + style(i) + ">" + html.EscapeString(header) + "</th>")
+	}
+	w.WriteString("</tr>\n  </thead>\n  <tbody>\n")
+	for _, row := range rows {
+		w.WriteString("    <tr>")
+		for i, cell := range row {
+			w.WriteString("<td" + style(i) + ">" + html.EscapeString(cell) + "</td>")
+		}
+		w.WriteString("</tr>\n")
+	}
+	w.WriteString("  </tbody>\n</table>\n")
+}
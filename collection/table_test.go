@@ -0,0 +1,127 @@
+package collection
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func renderTable[T any](t *testing.T, c *Collection[T], opts TableOptions) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := c.RenderTable(&buf, opts); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return buf.String()
+}
+
+func TestRenderTableASCII(t *testing.T) {
+	type Employee struct {
+		ID     int     `json:"id" table:"编号,order=-1"`
+		Name   string  `json:"name" table:"姓名"`
+		Dept   string  `json:"dept" table:",align=center"`
+		Salary float64 `json:"salary"`
+		Note   *string `table:"-"`
+	}
+	c := New(
+		Employee{ID: 1, Name: "张三", Dept: "研发", Salary: 12000.5},
+		Employee{ID: 20, Name: "Bob", Dept: "ops", Salary: 900},
+	)
+	expected := "" +
+		"+------+------+------+---------+\n" +
+		"| 编号 | 姓名 | dept | salary  |\n" +
+		"+------+------+------+---------+\n" +
+		"|    1 | 张三 | 研发 | 12000.5 |\n" +
+		"|   20 | Bob  | ops  |     900 |\n" +
+		"+------+------+------+---------+\n"
+	if got := renderTable(t, c, TableOptions{}); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestRenderTableMarkdown(t *testing.T) {
+	c := New(map[string]any{"name": "a|b", "score": 1}, map[string]any{"name": "李四"})
+	expected := "" +
+		"| name | score |\n" +
+		"| ---- | ----- |\n" +
+		"| a\\|b | 1     |\n" +
+		"| 李四 |       |\n"
+	if got := renderTable(t, c, TableOptions{Format: TableMarkdown}); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	type Employee struct {
+		ID     int     `json:"id"`
+		Name   string  `json:"name" table:"姓名"`
+		Salary float64 `json:"salary"`
+	}
+	employees := New(Employee{ID: 1, Name: "张三", Salary: 12000.5}, Employee{ID: 20, Name: "Bob", Salary: 900})
+	expected = "" +
+		"| 姓名 | salary |\n" +
+		"| ---- | -----: |\n" +
+		"| 张三 |  1200… |\n" +
+		"| Bob  |    900 |\n"
+	got := renderTable(t, employees, TableOptions{Format: TableMarkdown, Columns: []string{"Name", "salary"}, MaxWidth: 5})
+	if got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestRenderTableTSV(t *testing.T) {
+	type Employee struct {
+		ID     int     `json:"id" table:"编号"`
+		Name   string  `json:"name" table:"姓名"`
+		Dept   string  `json:"dept"`
+		Salary float64 `json:"salary"`
+	}
+	c := New(&Employee{ID: 1, Name: "a\tb"}, nil)
+	expected := "编号\t姓名\tdept\tsalary\n1\ta b\t\t0\n\t\t\t\n"
+	if got := renderTable(t, c, TableOptions{Format: TableTSV}); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestRenderTableHTML(t *testing.T) {
+	c := New("<b>", "x")
+	expected := "<table>\n" +
+		"  <thead>\n    <tr><th>value</th></tr>\n  </thead>\n" +
+		"  <tbody>\n    <tr><td>&lt;b&gt;</td></tr>\n    <tr><td>x</td></tr>\n  </tbody>\n" +
+		"</table>\n"
+	if got := renderTable(t, c, TableOptions{Format: TableHTML}); got != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+
+	type Employee struct {
+		ID   int
+		Name string
+	}
+	employees := New(Employee{ID: 1, Name: "Alice"}, Employee{ID: 20, Name: "Bob"})
+	got := renderTable(t, employees, TableOptions{Format: TableHTML, Columns: []string{"ID"}})
+	if !strings.Contains(got, `<td style="text-align: right">20</td>`) {
+		t.Errorf("Expected right aligned numeric cell, got:\n%s", got)
+	}
+}
+
+func TestRenderTableErrors(t *testing.T) {
+	type Employee struct {
+		Name string
+		Note *string `table:"-"`
+	}
+	var buf bytes.Buffer
+	if err := New(Employee{Name: "Alice"}).RenderTable(&buf, TableOptions{Columns: []string{"Note"}}); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+}
+
+func TestStringWidth(t *testing.T) {
+	tests := map[string]int{"abc": 3, "张三": 4, "ｱ": 1, "Ａ": 2, "é": 1, "한국": 4, "😀": 2}
+	for s, expected := range tests {
+		if width := stringWidth(s); width != expected {
+			t.Errorf("stringWidth(%q): expected %d, got %d", s, expected, width)
+		}
+	}
+	if truncated := truncateWidth("张三李四", 5); truncated != "张三…" {
+		t.Errorf("Expected 张三…, got %s", truncated)
+	}
+}
@@ -0,0 +1,366 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrInvalidOperator Where 的比较运算符不受支持
+var ErrInvalidOperator = errors.New("collection: invalid operator")
+
+// valueKind 比较时使用的值类别
+type valueKind int
+
+const (
+	kindOther valueKind = iota
+	kindInt
+	kindUint
+	kindFloat
+	kindString
+	kindBool
+	kindTime
+)
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// kindOf 返回类型的比较类别
+func kindOf(t reflect.Type) valueKind {
+	if t == timeType {
+		return kindTime
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return kindInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return kindUint
+	case reflect.Float32, reflect.Float64:
+		return kindFloat
+	case reflect.String:
+		return kindString
+	case reflect.Bool:
+		return kindBool
+	default:
+		return kindOther
+	}
+}
+
+// isNumeric 判断类别是否为数值
+func isNumeric(k valueKind) bool {
+	return k == kindInt || k == kindUint || k == kindFloat
+}
+
+// toFloat 将数值转换为float64
+func toFloat(v reflect.Value) float64 {
+	switch kindOf(v.Type()) {
+	case kindInt:
+		return float64(v.Int())
+	case kindUint:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+// compareValues 比较两个已解引用的值，返回比较结果以及两者是否可比较
+// 布尔值和其他类型只能判断相等，不相等时返回1；NaN与任何值都不可比较
+func compareValues(a, b reflect.Value) (int, bool) {
+	ka, kb := kindOf(a.Type()), kindOf(b.Type())
+	switch {
+	case isNaN(a, ka) || isNaN(b, kb):
+		return 0, false
+	case isNumeric(ka) && isNumeric(kb):
+		return compareNumbers(a, b, ka, kb), true
+	case ka != kb:
+		return 0, false
+	case ka == kindString:
+		return strings.Compare(a.String(), b.String()), true
+	case ka == kindBool:
+		return boolToCompare(a.Bool() == b.Bool()), true
+	case ka == kindTime:
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time)), true
+	case a.Type() == b.Type():
+		return boolToCompare(reflect.DeepEqual(a.Interface(), b.Interface())), true
+	default:
+		return 0, false
+	}
+}
+
+// isNaN 判断值是否为NaN
+func isNaN(v reflect.Value, k valueKind) bool {
+	return k == kindFloat && math.IsNaN(v.Float())
+}
+
+// compareNumbers 比较两个数值，整数之间的比较不经过float64以避免精度损失
+func compareNumbers(a, b reflect.Value, ka, kb valueKind) int {
+	switch {
+	case ka == kindFloat || kb == kindFloat:
+		x, y := toFloat(a), toFloat(b)
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+		return 0
+	case ka == kindInt && kb == kindInt:
+		return compareOrdered(a.Int(), b.Int())
+	case ka == kindUint && kb == kindUint:
+		return compareOrdered(a.Uint(), b.Uint())
+	case ka == kindInt:
+		if a.Int() < 0 {
+			return -1
+		}
+		return compareOrdered(uint64(a.Int()), b.Uint())
+	default:
+		if b.Int() < 0 {
+			return 1
+		}
+		return compareOrdered(a.Uint(), uint64(b.Int()))
+	}
+}
+
+// compareOrdered 比较两个整数
+func compareOrdered[N int64 | uint64](a, b N) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// boolToCompare 将相等判断转换为比较结果
+func boolToCompare(equal bool) int {
+	if equal {
+		return 0
+	}
+	return 1
+}
+
+// checkComparable 检查字段类型能否与value比较，ordered为true时还要求支持大小比较
+// 接口类型的字段在运行时按元素检查，不兼容的元素视为不匹配
+func checkComparable(path *fieldPath, typeName string, value reflect.Value, ordered bool) error {
+	fieldType := indirectType(path.typ)
+	if fieldType.Kind() == reflect.Interface {
+		return nil
+	}
+	fk, vk := kindOf(fieldType), kindOf(value.Type())
+	compatible := (isNumeric(fk) && isNumeric(vk)) || (fk == vk && fk != kindOther) ||
+		(fk == kindOther && value.Type() == fieldType)
+	if !compatible {
+		return &FieldError{Type: typeName, Field: path.path, Err: ErrFieldType,
+			Detail: fmt.Sprintf("cannot compare %s with %s", fieldType, value.Type())}
+	}
+	if ordered && (fk == kindBool || fk == kindOther) {
+		return &FieldError{Type: typeName, Field: path.path, Err: ErrFieldType,
+			Detail: fmt.Sprintf("%s does not support ordering", fieldType)}
+	}
+	return nil
+}
+
+// filterField 解析字段后按字段值过滤集合，match 的参数为已解引用的字段值，字段为null时ok为false
+func (c *Collection[T]) filterField(field string, check func(*fieldPath, string) error, match func(v reflect.Value, ok bool) bool) (*Collection[T], error) {
+	path, err := resolveFieldPathFor[T](field)
+	if err != nil {
+		return nil, err
+	}
+	if err := check(path, reflect.TypeFor[T]().String()); err != nil {
+		return nil, err
+	}
+	return c.Filter(func(item T) bool {
+		v, ok := path.get(reflect.ValueOf(&item).Elem())
+		if ok {
+			v, ok = indirect(v)
+		}
+		return match(v, ok)
+	}), nil
+}
+
+// whereOperators Where 支持的运算符及其对应的比较结果判断
+var whereOperators = map[string]func(int) bool{
+	"=":  func(r int) bool { return r == 0 },
+	"==": func(r int) bool { return r == 0 },
+	"!=": func(r int) bool { return r != 0 },
+	"<>": func(r int) bool { return r != 0 },
+	"<":  func(r int) bool { return r < 0 },
+	"<=": func(r int) bool { return r <= 0 },
+	">":  func(r int) bool { return r > 0 },
+	">=": func(r int) bool { return r >= 0 },
+}
+
+// Where 按字段过滤集合，字段可以使用Go字段名、json标签名或以"."分隔的嵌套路径
+// 支持的运算符：= == != <> < <= > >=；value为nil时 = 和 != 分别等同于 WhereNull 和 WhereNotNull
+// 字段为null（路径上存在nil指针）的元素不匹配任何比较
+func (c *Collection[T]) Where(field, op string, value any) (*Collection[T], error) {
+	test, ok := whereOperators[op]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidOperator, op)
+	}
+	target, notNull := indirect(reflect.ValueOf(value))
+	if !notNull {
+		switch op {
+		case "=", "==":
+			return c.WhereNull(field)
+		case "!=", "<>":
+			return c.WhereNotNull(field)
+		default:
+			return nil, fmt.Errorf("%w: %q cannot be used with nil", ErrInvalidOperator, op)
+		}
+	}
+	ordered := op != "=" && op != "==" && op != "!=" && op != "<>"
+	return c.filterField(field, func(path *fieldPath, typeName string) error {
+		return checkComparable(path, typeName, target, ordered)
+	}, func(v reflect.Value, ok bool) bool {
+		if !ok {
+			return false
+		}
+		r, comparable := compareValues(v, target)
+		if !comparable || (ordered && (kindOf(v.Type()) == kindBool || kindOf(v.Type()) == kindOther)) {
+			return false
+		}
+		return test(r)
+	})
+}
+
+// expandValues 当只传入一个切片或数组时将其展开
+func expandValues(values []any) []reflect.Value {
+	if len(values) == 1 {
+		v := reflect.ValueOf(values[0])
+		if (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8 {
+			expanded := make([]reflect.Value, v.Len())
+			for i := range expanded {
+				expanded[i], _ = indirect(v.Index(i))
+			}
+			return expanded
+		}
+	}
+	expanded := make([]reflect.Value, len(values))
+	for i, value := range values {
+		expanded[i], _ = indirect(reflect.ValueOf(value))
+	}
+	return expanded
+}
+
+// whereIn WhereIn 和 WhereNotIn 的公共实现
+func (c *Collection[T]) whereIn(field string, values []any, in bool) (*Collection[T], error) {
+	targets := make([]reflect.Value, 0)
+	for _, target := range expandValues(values) {
+		if target.IsValid() {
+			targets = append(targets, target)
+		}
+	}
+	return c.filterField(field, func(path *fieldPath, typeName string) error {
+		for _, target := range targets {
+			if err := checkComparable(path, typeName, target, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(v reflect.Value, ok bool) bool {
+		if !ok {
+			return false
+		}
+		for _, target := range targets {
+			if r, comparable := compareValues(v, target); comparable && r == 0 {
+				return in
+			}
+		}
+		return !in
+	})
+}
+
+// WhereIn 过滤字段值在给定值中的元素，只传入一个切片时会将其展开
+func (c *Collection[T]) WhereIn(field string, values ...any) (*Collection[T], error) {
+	return c.whereIn(field, values, true)
+}
+
+// WhereNotIn 过滤字段值不在给定值中的元素，字段为null的元素被排除
+func (c *Collection[T]) WhereNotIn(field string, values ...any) (*Collection[T], error) {
+	return c.whereIn(field, values, false)
+}
+
+// WhereNull 过滤字段为null的元素（字段或路径上的指针、接口为nil）
+func (c *Collection[T]) WhereNull(field string) (*Collection[T], error) {
+	return c.filterField(field, func(*fieldPath, string) error { return nil }, func(_ reflect.Value, ok bool) bool {
+		return !ok
+	})
+}
+
+// WhereNotNull 过滤字段不为null的元素
+func (c *Collection[T]) WhereNotNull(field string) (*Collection[T], error) {
+	return c.filterField(field, func(*fieldPath, string) error { return nil }, func(_ reflect.Value, ok bool) bool {
+		return ok
+	})
+}
+
+// WhereBetween 过滤字段值在[low, high]闭区间内的元素
+func (c *Collection[T]) WhereBetween(field string, low, high any) (*Collection[T], error) {
+	lowValue, lowOK := indirect(reflect.ValueOf(low))
+	highValue, highOK := indirect(reflect.ValueOf(high))
+	if !lowOK || !highOK {
+		return nil, fmt.Errorf("%w: WhereBetween bounds cannot be nil", ErrInvalidOperator)
+	}
+	return c.filterField(field, func(path *fieldPath, typeName string) error {
+		if err := checkComparable(path, typeName, lowValue, true); err != nil {
+			return err
+		}
+		return checkComparable(path, typeName, highValue, true)
+	}, func(v reflect.Value, ok bool) bool {
+		if !ok {
+			return false
+		}
+		lowResult, lowComparable := compareValues(v, lowValue)
+		highResult, highComparable := compareValues(v, highValue)
+		return lowComparable && highComparable && lowResult >= 0 && highResult <= 0
+	})
+}
+
+// WhereLike 使用SQL LIKE语法匹配字符串字段（区分大小写）
+// % 匹配任意数量的字符，_ 匹配单个字符，使用 \% 和 \_ 匹配字面量
+func (c *Collection[T]) WhereLike(field, pattern string) (*Collection[T], error) {
+	re := likePattern(pattern)
+	return c.filterField(field, func(path *fieldPath, typeName string) error {
+		fieldType := indirectType(path.typ)
+		if fieldType.Kind() != reflect.String && fieldType.Kind() != reflect.Interface {
+			return &FieldError{Type: typeName, Field: path.path, Err: ErrFieldType,
+				Detail: fmt.Sprintf("LIKE requires a string field, got %s", fieldType)}
+		}
+		return nil
+	}, func(v reflect.Value, ok bool) bool {
+		return ok && v.Kind() == reflect.String && re.MatchString(v.String())
+	})
+}
+
+// likePattern 将SQL LIKE模式转换为正则表达式
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString(`(?s)^`)
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			b.WriteString(`.*`)
+		case r == '_':
+			b.WriteString(`.`)
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		b.WriteString(regexp.QuoteMeta(`\`))
+	}
+	b.WriteString(`$`)
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,230 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+type whereAddress struct {
+	City string `json:"city"`
+	Zip  *string
+}
+
+type whereBase struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type whereUser struct {
+	whereBase
+	Name    string        `json:"name"`
+	Age     uint8         `json:"age"`
+	Score   float64       `json:"score"`
+	Active  bool          `json:"active"`
+	Address *whereAddress `json:"address"`
+	Meta    any           `json:"meta"`
+}
+
+// whereFixtures 供 Where、按字段和按路径访问的测试共用
+func whereFixtures() *Collection[whereUser] {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	zip := "10001"
+	return New(
+		whereUser{whereBase{1, day(1)}, "Alice", 30, 9.5, true, &whereAddress{"Beijing", &zip}, "vip"},
+		whereUser{whereBase{2, day(2)}, "Bob", 25, 7, false, &whereAddress{"Shanghai", nil}, 3},
+		whereUser{whereBase{3, day(3)}, "Carol", 35, 8.25, true, nil, nil},
+		whereUser{whereBase{4, day(4)}, "alice_2", 18, 6, false, &whereAddress{"Beijing", nil}, "vip"},
+	)
+}
+
+func whereNames(t *testing.T, c *Collection[whereUser], err error) []string {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return Map(c, func(u whereUser) string { return u.Name }).All()
+}
+
+func TestWhere(t *testing.T) {
+	users := whereFixtures()
+	tests := []struct {
+		field    string
+		op       string
+		value    any
+		expected []string
+	}{
+		{"Age", ">", 25, []string{"Alice", "Carol"}},
+		{"age", "<=", int64(25), []string{"Bob", "alice_2"}},
+		{"Score", ">=", 8, []string{"Alice", "Carol"}},
+		{"Age", "=", 30.0, []string{"Alice"}},
+		{"Age", ">", -1, []string{"Alice", "Bob", "Carol", "alice_2"}},
+		{"name", "!=", "Bob", []string{"Alice", "Carol", "alice_2"}},
+		{"Name", "<", "B", []string{"Alice"}},
+		{"Active", "==", true, []string{"Alice", "Carol"}},
+		{"id", "<>", 2, []string{"Alice", "Carol", "alice_2"}},
+		{"ID", "=", 3, []string{"Carol"}},
+		{"created_at", ">", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), []string{"Carol", "alice_2"}},
+		{"Address.City", "=", "Beijing", []string{"Alice", "alice_2"}},
+		{"address.city", "!=", "Beijing", []string{"Bob"}},
+		{"Address.Zip", "=", "10001", []string{"Alice"}},
+		{"Meta", "=", "vip", []string{"Alice", "alice_2"}},
+		{"Meta", ">", 1, []string{"Bob"}},
+		{"Address", "=", nil, []string{"Carol"}},
+		{"Address", "!=", nil, []string{"Alice", "Bob", "alice_2"}},
+	}
+	for _, tt := range tests {
+		filtered, err := users.Where(tt.field, tt.op, tt.value)
+		if names := whereNames(t, filtered, err); !slices.Equal(names, tt.expected) {
+			t.Errorf("Where(%q, %q, %v): expected %v, got %v", tt.field, tt.op, tt.value, tt.expected, names)
+		}
+	}
+}
+
+func TestWhereErrors(t *testing.T) {
+	users := whereFixtures()
+
+	_, err := users.Where("Nickname", "=", "x")
+	var fieldErr *FieldError
+	if !errors.Is(err, ErrUnknownField) || !errors.As(err, &fieldErr) || fieldErr.Field != "Nickname" {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+	if _, err := users.Where("Address.Street", "=", "x"); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown nested field error, got %v", err)
+	}
+	if _, err := users.Where("Name.First", "=", "x"); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected non-struct error, got %v", err)
+	}
+	if _, err := users.Where("Age", "=", "30"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+	if _, err := users.Where("Active", ">", false); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected ordering error, got %v", err)
+	}
+	if _, err := users.Where("Age", "~", 1); !errors.Is(err, ErrInvalidOperator) {
+		t.Errorf("Expected invalid operator error, got %v", err)
+	}
+	if _, err := users.Where("Age", ">", nil); !errors.Is(err, ErrInvalidOperator) {
+		t.Errorf("Expected invalid operator error for nil, got %v", err)
+	}
+}
+
+func TestWhereIn(t *testing.T) {
+	users := whereFixtures()
+
+	filtered, err := users.WhereIn("Name", "Bob", "Carol", "Dave")
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Bob", "Carol"}) {
+		t.Errorf("Expected [Bob Carol], got %v", names)
+	}
+	filtered, err = users.WhereIn("id", []int{1, 4})
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Alice", "alice_2"}) {
+		t.Errorf("Expected [Alice alice_2], got %v", names)
+	}
+	filtered, err = users.WhereNotIn("Address.City", "Beijing")
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Bob"}) {
+		t.Errorf("Expected [Bob], got %v", names)
+	}
+	if _, err := users.WhereIn("Age", 1, "two"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+}
+
+func TestWhereNull(t *testing.T) {
+	users := whereFixtures()
+
+	filtered, err := users.WhereNull("Address.Zip")
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Bob", "Carol", "alice_2"}) {
+		t.Errorf("Expected [Bob Carol alice_2], got %v", names)
+	}
+	filtered, err = users.WhereNotNull("meta")
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Alice", "Bob", "alice_2"}) {
+		t.Errorf("Expected [Alice Bob alice_2], got %v", names)
+	}
+	if _, err := users.WhereNull("Missing"); !errors.Is(err, ErrUnknownField) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+}
+
+func TestWhereBetween(t *testing.T) {
+	users := whereFixtures()
+
+	filtered, err := users.WhereBetween("Age", 25, 30)
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Alice", "Bob"}) {
+		t.Errorf("Expected [Alice Bob], got %v", names)
+	}
+	filtered, err = users.WhereBetween("Score", 6.5, 9)
+	if names := whereNames(t, filtered, err); !slices.Equal(names, []string{"Bob", "Carol"}) {
+		t.Errorf("Expected [Bob Carol], got %v", names)
+	}
+	if _, err := users.WhereBetween("Name", 1, 2); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+}
+
+func TestWhereLike(t *testing.T) {
+	users := whereFixtures()
+	tests := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"A%", []string{"Alice"}},
+		{"%o%", []string{"Bob", "Carol"}},
+		{"B_b", []string{"Bob"}},
+		{`alice\_%`, []string{"alice_2"}},
+		{"%", []string{"Alice", "Bob", "Carol", "alice_2"}},
+	}
+	for _, tt := range tests {
+		filtered, err := users.WhereLike("name", tt.pattern)
+		if names := whereNames(t, filtered, err); !slices.Equal(names, tt.expected) {
+			t.Errorf("WhereLike(%q): expected %v, got %v", tt.pattern, tt.expected, names)
+		}
+	}
+	if _, err := users.WhereLike("Age", "1%"); !errors.Is(err, ErrFieldType) {
+		t.Errorf("Expected field type error, got %v", err)
+	}
+}
+
+func TestWherePointerElements(t *testing.T) {
+	users := New(&whereUser{Name: "Alice", Age: 30}, nil, &whereUser{Name: "Bob", Age: 20})
+	filtered, err := users.Where("Age", ">", 25)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first, ok := filtered.First(); !ok || filtered.Count() != 1 || first.Name != "Alice" {
+		t.Errorf("Expected [Alice], got %v", filtered.All())
+	}
+}
+
+func TestWhereNaN(t *testing.T) {
+	type Reading struct {
+		Name  string
+		Score float64
+	}
+	readings := New(Reading{"nan", math.NaN()}, Reading{"five", 5})
+	tests := []struct {
+		op       string
+		value    any
+		expected []string
+	}{
+		{"=", 5.0, []string{"five"}},
+		{"<", 10, []string{"five"}},
+		{">", 0, []string{"five"}},
+		{"=", math.NaN(), []string{}},
+	}
+	for _, tt := range tests {
+		filtered, err := readings.Where("Score", tt.op, tt.value)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		names := Map(filtered, func(r Reading) string { return r.Name }).All()
+		if !slices.Equal(names, tt.expected) {
+			t.Errorf("Where(Score %s %v): expected %v, got %v", tt.op, tt.value, tt.expected, names)
+		}
+	}
+	filtered, err := readings.WhereBetween("Score", math.Inf(-1), math.Inf(1))
+	if err != nil || filtered.Count() != 1 {
+		t.Errorf("Expected NaN to be excluded from WhereBetween, got %v (%v)", filtered.All(), err)
+	}
+}
@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidSize 分块、窗口或分组的大小不合法
+var ErrInvalidSize = errors.New("collection: invalid size")
+
+// ChunkCollection 将集合分割成指定大小的块，返回由子集合组成的集合
+// 与 Chunk 不同，size小于等于0时返回错误
+func ChunkCollection[T any](c *Collection[T], size int) (*Collection[*Collection[T]], error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: chunk size must be positive, got %d", ErrInvalidSize, size)
+	}
+	count := len(c.items) / size
+	if len(c.items)%size != 0 {
+		count++
+	}
+	chunks := make([]*Collection[T], 0, count)
+	for i := 0; i < len(c.items); i += size {
+		end := min(i+size, len(c.items))
+		chunks = append(chunks, &Collection[T]{items: c.items[i:end:end]})
+	}
+	return &Collection[*Collection[T]]{items: chunks}, nil
+}
+
+// Sliding 返回大小为size、每次移动step个元素的滑动窗口，只包含完整的窗口
+func Sliding[T any](c *Collection[T], size, step int) (*Collection[*Collection[T]], error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: window size must be positive, got %d", ErrInvalidSize, size)
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("%w: window step must be positive, got %d", ErrInvalidSize, step)
+	}
+	windows := make([]*Collection[T], 0)
+	for i := 0; size <= len(c.items)-i; i += step {
+		windows = append(windows, &Collection[T]{items: c.items[i : i+size : i+size]})
+		if step > len(c.items)-i {
+			break
+		}
+	}
+	return &Collection[*Collection[T]]{items: windows}, nil
+}
+
+// ChunkWhile 当回调函数对相邻的两个元素返回true时将它们放入同一块，否则开始新的块
+func ChunkWhile[T any](c *Collection[T], fn func(prev, cur T) bool) *Collection[*Collection[T]] {
+	chunks := make([]*Collection[T], 0)
+	start := 0
+	for i := 1; i <= len(c.items); i++ {
+		if i == len(c.items) || !fn(c.items[i-1], c.items[i]) {
+			chunks = append(chunks, &Collection[T]{items: c.items[start:i:i]})
+			start = i
+		}
+	}
+	return &Collection[*Collection[T]]{items: chunks}
+}
+
+// SplitIn 将集合尽量均匀地分成n组，各组大小最多相差1，靠前的组较大
+// 元素数量少于n时只返回非空的组
+func SplitIn[T any](c *Collection[T], n int) (*Collection[*Collection[T]], error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: group count must be positive, got %d", ErrInvalidSize, n)
+	}
+	groups := make([]*Collection[T], 0, min(n, len(c.items)))
+	base, extra := len(c.items)/n, len(c.items)%n
+	start := 0
+	for i := 0; i < n && start < len(c.items); i++ {
+		size := base
+		if i < extra {
+			size++
+		}
+		end := start + size
+		groups = append(groups, &Collection[T]{items: c.items[start:end:end]})
+		start = end
+	}
+	return &Collection[*Collection[T]]{items: groups}, nil
+}
@@ -0,0 +1,121 @@
+package collection
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// chunkItems 将子集合展开为切片以便比较
+func chunkItems[T any](c *Collection[*Collection[T]]) [][]T {
+	return Map(c, func(chunk *Collection[T]) []T { return chunk.All() }).All()
+}
+
+func TestChunkCollection(t *testing.T) {
+	chunks, err := ChunkCollection(New(1, 2, 3, 4, 5), 2)
+	if err != nil {
+		t.Fatalf("ChunkCollection failed: %v", err)
+	}
+	counts := Map(chunks, func(chunk *Collection[int]) int { return chunk.Count() })
+	if !slices.Equal(counts.All(), []int{2, 2, 1}) {
+		t.Errorf("Expected chunk sizes [2 2 1], got %v", counts.All())
+	}
+
+	_, err = ChunkCollection(New(1, 2), 0)
+	if !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestChunkCollectionPushDoesNotOverwrite(t *testing.T) {
+	c := New(1, 2, 3, 4)
+	chunks, _ := ChunkCollection(c, 2)
+	first, _ := chunks.First()
+	first.Push(100)
+	if !slices.Equal(c.All(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected source unchanged, got %v", c.All())
+	}
+}
+
+func TestSliding(t *testing.T) {
+	windows, err := Sliding(New(1, 2, 3, 4, 5), 3, 1)
+	if err != nil {
+		t.Fatalf("Sliding failed: %v", err)
+	}
+	got := chunkItems(windows)
+	if len(got) != 3 || !slices.Equal(got[0], []int{1, 2, 3}) || !slices.Equal(got[2], []int{3, 4, 5}) {
+		t.Errorf("Unexpected windows %v", got)
+	}
+
+	windows, _ = Sliding(New(1, 2, 3, 4, 5, 6), 2, 3)
+	got = chunkItems(windows)
+	if len(got) != 2 || !slices.Equal(got[1], []int{4, 5}) {
+		t.Errorf("Unexpected stepped windows %v", got)
+	}
+
+	windows, _ = Sliding(New(1, 2), 3, 1)
+	if !windows.IsEmpty() {
+		t.Errorf("Expected no windows, got %v", chunkItems(windows))
+	}
+
+	if _, err := Sliding(New(1), -1, 1); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize for size, got %v", err)
+	}
+	if _, err := Sliding(New(1), 1, 0); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize for step, got %v", err)
+	}
+}
+
+func TestChunkWhile(t *testing.T) {
+	chunks := ChunkWhile(New(1, 2, 3, 7, 8, 10), func(prev, cur int) bool { return cur == prev+1 })
+	got := chunkItems(chunks)
+	if len(got) != 3 || !slices.Equal(got[0], []int{1, 2, 3}) || !slices.Equal(got[1], []int{7, 8}) || !slices.Equal(got[2], []int{10}) {
+		t.Errorf("Unexpected chunks %v", got)
+	}
+
+	if !ChunkWhile(New[int](), func(prev, cur int) bool { return true }).IsEmpty() {
+		t.Error("Expected no chunks for empty collection")
+	}
+}
+
+func TestSplitIn(t *testing.T) {
+	groups, err := SplitIn(New(1, 2, 3, 4, 5, 6, 7), 3)
+	if err != nil {
+		t.Fatalf("SplitIn failed: %v", err)
+	}
+	got := chunkItems(groups)
+	if len(got) != 3 || !slices.Equal(got[0], []int{1, 2, 3}) || !slices.Equal(got[1], []int{4, 5}) || !slices.Equal(got[2], []int{6, 7}) {
+		t.Errorf("Unexpected groups %v", got)
+	}
+
+	groups, _ = SplitIn(New(1, 2), 5)
+	if groups.Count() != 2 {
+		t.Errorf("Expected 2 non-empty groups, got %d", groups.Count())
+	}
+
+	if _, err := SplitIn(New(1), 0); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Expected ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestWindowHugeSizes(t *testing.T) {
+	chunks, err := ChunkCollection(New(1, 2, 3), math.MaxInt)
+	if err != nil || chunks.Count() != 1 {
+		t.Errorf("Expected a single chunk, got %v %v", chunkItems(chunks), err)
+	}
+
+	windows, err := Sliding(New(1, 2, 3), 1, math.MaxInt)
+	if got := chunkItems(windows); err != nil || len(got) != 1 || !slices.Equal(got[0], []int{1}) {
+		t.Errorf("Expected [[1]], got %v %v", got, err)
+	}
+	windows, _ = Sliding(New(1, 2, 3), math.MaxInt, math.MaxInt)
+	if !windows.IsEmpty() {
+		t.Errorf("Expected no windows, got %v", chunkItems(windows))
+	}
+
+	groups, err := SplitIn(New(1, 2, 3), math.MaxInt)
+	if err != nil || groups.Count() != 3 {
+		t.Errorf("Expected 3 groups, got %v %v", chunkItems(groups), err)
+	}
+}
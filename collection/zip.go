@@ -0,0 +1,78 @@
+package collection
+
+// Pair 类型化的二元组
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple 类型化的三元组
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip 将两个集合按位置合并为二元组集合，长度以较短的集合为准
+func Zip[T, U any](c1 *Collection[T], c2 *Collection[U]) *Collection[Pair[T, U]] {
+	return ZipWith(c1, c2, func(a T, b U) Pair[T, U] {
+		return Pair[T, U]{First: a, Second: b}
+	})
+}
+
+// ZipAny 将两个集合合并为 [2]any 集合
+//
+// Deprecated: 使用返回类型化 Pair 的 Zip 代替
+func ZipAny[T, U any](c1 *Collection[T], c2 *Collection[U]) *Collection[[2]any] {
+	return ZipWith(c1, c2, func(a T, b U) [2]any {
+		return [2]any{a, b}
+	})
+}
+
+// Zip3 将三个集合按位置合并为三元组集合，长度以最短的集合为准
+func Zip3[T, U, V any](c1 *Collection[T], c2 *Collection[U], c3 *Collection[V]) *Collection[Triple[T, U, V]] {
+	length := min(len(c1.items), len(c2.items), len(c3.items))
+	zipped := make([]Triple[T, U, V], length)
+	for i := 0; i < length; i++ {
+		zipped[i] = Triple[T, U, V]{First: c1.items[i], Second: c2.items[i], Third: c3.items[i]}
+	}
+	return &Collection[Triple[T, U, V]]{items: zipped}
+}
+
+// ZipWith 使用回调函数按位置合并两个集合，长度以较短的集合为准
+func ZipWith[T, U, R any](c1 *Collection[T], c2 *Collection[U], fn func(T, U) R) *Collection[R] {
+	length := min(len(c1.items), len(c2.items))
+	zipped := make([]R, length)
+	for i := 0; i < length; i++ {
+		zipped[i] = fn(c1.items[i], c2.items[i])
+	}
+	return &Collection[R]{items: zipped}
+}
+
+// ZipLongest 按位置合并两个集合，长度以较长的集合为准，较短的集合使用填充值补齐
+func ZipLongest[T, U any](c1 *Collection[T], c2 *Collection[U], fill1 T, fill2 U) *Collection[Pair[T, U]] {
+	length := max(len(c1.items), len(c2.items))
+	zipped := make([]Pair[T, U], length)
+	for i := 0; i < length; i++ {
+		pair := Pair[T, U]{First: fill1, Second: fill2}
+		if i < len(c1.items) {
+			pair.First = c1.items[i]
+		}
+		if i < len(c2.items) {
+			pair.Second = c2.items[i]
+		}
+		zipped[i] = pair
+	}
+	return &Collection[Pair[T, U]]{items: zipped}
+}
+
+// Unzip 将二元组集合拆分为两个集合
+func Unzip[T, U any](c *Collection[Pair[T, U]]) (*Collection[T], *Collection[U]) {
+	firsts := make([]T, len(c.items))
+	seconds := make([]U, len(c.items))
+	for i, pair := range c.items {
+		firsts[i] = pair.First
+		seconds[i] = pair.Second
+	}
+	return &Collection[T]{items: firsts}, &Collection[U]{items: seconds}
+}
@@ -0,0 +1,55 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	zipped := Zip(New(1, 2, 3), New("a", "b"))
+	if zipped.Count() != 2 {
+		t.Fatalf("Expected 2 pairs, got %d", zipped.Count())
+	}
+	pair, _ := zipped.Get(1)
+	if pair.First != 2 || pair.Second != "b" {
+		t.Errorf("Expected {2 b}, got %v", pair)
+	}
+}
+
+func TestZipAny(t *testing.T) {
+	zipped := ZipAny(New(1, 2), New("a", "b"))
+	pair, _ := zipped.First()
+	if pair[0].(int) != 1 || pair[1].(string) != "a" {
+		t.Errorf("Expected [1 a], got %v", pair)
+	}
+}
+
+func TestZip3(t *testing.T) {
+	zipped := Zip3(New(1, 2), New("a", "b", "c"), New(true, false))
+	triple, _ := zipped.Last()
+	if zipped.Count() != 2 || triple != (Triple[int, string, bool]{2, "b", false}) {
+		t.Errorf("Expected 2 triples ending in {2 b false}, got %v", zipped.All())
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	sums := ZipWith(New(1, 2, 3), New(10, 20, 30), func(a, b int) int { return a + b })
+	if !slices.Equal(sums.All(), []int{11, 22, 33}) {
+		t.Errorf("Expected [11 22 33], got %v", sums.All())
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	zipped := ZipLongest(New(1), New("a", "b", "c"), -1, "")
+	expected := []Pair[int, string]{{1, "a"}, {-1, "b"}, {-1, "c"}}
+	if !slices.Equal(zipped.All(), expected) {
+		t.Errorf("Expected %v, got %v", expected, zipped.All())
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	numbers, letters := Unzip(Zip(New(1, 2), New("a", "b")))
+	if !slices.Equal(numbers.All(), []int{1, 2}) || !slices.Equal(letters.All(), []string{"a", "b"}) {
+		t.Errorf("Unzip failed, got %v %v", numbers.All(), letters.All())
+	}
+}